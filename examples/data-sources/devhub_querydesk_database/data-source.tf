@@ -0,0 +1,19 @@
+data "devhub_querydesk_database" "billing" {
+  name = "billing"
+}
+
+resource "devhub_workflow" "example" {
+  name = "refresh_invoices"
+
+  steps = [
+    {
+      name = "query-step"
+
+      query_action = {
+        query         = "REFRESH MATERIALIZED VIEW invoices_summary"
+        credential_id = data.devhub_querydesk_database.billing.credential_ids["postgres"]
+        timeout       = 60
+      }
+    }
+  ]
+}
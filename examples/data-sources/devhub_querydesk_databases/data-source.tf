@@ -0,0 +1,8 @@
+data "devhub_querydesk_databases" "production" {
+  group   = "production"
+  adapter = "POSTGRES"
+}
+
+output "production_database_names" {
+  value = [for database in data.devhub_querydesk_databases.production.databases : database.name]
+}
@@ -0,0 +1,8 @@
+data "devhub_terradesk_workspace" "network" {
+  name = "network"
+}
+
+resource "devhub_terradesk_run_trigger" "network_to_app" {
+  source_workspace_id = data.devhub_terradesk_workspace.network.id
+  target_workspace_id = devhub_terradesk_workspace.app.id
+}
@@ -0,0 +1,11 @@
+data "devhub_terradesk_workspace" "network" {
+  name = "network"
+}
+
+data "devhub_terradesk_workspace_outputs" "network" {
+  workspace_id = data.devhub_terradesk_workspace.network.id
+}
+
+locals {
+  vpc_id = data.devhub_terradesk_workspace_outputs.network.nonsensitive_values.vpc_id
+}
@@ -0,0 +1,8 @@
+data "devhub_terradesk_workspaces" "apps" {
+  repository  = "devhub-tools/infrastructure"
+  path_prefix = "terraform/apps/"
+}
+
+output "app_workspace_names" {
+  value = [for workspace in data.devhub_terradesk_workspaces.apps.workspaces : workspace.name]
+}
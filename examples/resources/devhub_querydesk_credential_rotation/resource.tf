@@ -0,0 +1,15 @@
+resource "devhub_querydesk_credential_rotation" "example" {
+  credential_id   = devhub_querydesk_database.example.credential_ids["postgres"]
+  rotation_period = "720h"
+
+  keepers = {
+    hostname = devhub_querydesk_database.example.hostname
+  }
+}
+
+# Feed the rotated password into the database's own user management.
+resource "postgresql_role" "app" {
+  name     = "postgres"
+  login    = true
+  password = devhub_querydesk_credential_rotation.example.password
+}
@@ -0,0 +1,6 @@
+# Databases can be imported by id or by name. Passwords, certs and keys are never
+# returned by the API, so the first apply after importing updates them in state and
+# sends the certs and keys again. Credentials using `password_wo` don't send the
+# password again.
+terraform import devhub_querydesk_database.example <database_id>
+terraform import devhub_querydesk_database.example name:<database_name>
@@ -8,8 +8,37 @@ resource "devhub_querydesk_database" "example" {
     {
       username           = "postgres"
       password           = "postgres"
-      reviews_required   = 0
+      reviews_required   = 1
       default_credential = true
+
+      review_policy = {
+        auto_approve_read_only = true
+        review_expiry          = "24h"
+      }
+    }
+  ]
+}
+
+resource "devhub_querydesk_database" "private" {
+  name     = "private_db"
+  adapter  = "POSTGRES"
+  hostname = "10.0.12.4"
+  database = "mydb"
+
+  ssh_tunnel = {
+    host                   = "bastion.example.com"
+    user                   = "devhub"
+    private_key            = file("devhub_bastion_ed25519")
+    known_host_fingerprint = "SHA256:uNiVztksCsDhcc0u9e8BujQXVUpKZIDTMczCvj3tD2s"
+  }
+
+  credentials = [
+    {
+      username            = "postgres"
+      password_wo         = var.private_db_password
+      password_wo_version = 1
+      reviews_required    = 0
+      default_credential  = true
     }
   ]
 }
@@ -0,0 +1,2 @@
+# Database permissions can be imported by specifying the database id and permission id.
+terraform import devhub_querydesk_database_permission.example <database_id>/<permission_id>
@@ -0,0 +1,22 @@
+data "devhub_role" "engineers" {
+  name = "Engineers"
+}
+
+data "devhub_user" "oncall" {
+  email = "oncall@example.com"
+}
+
+# grant a role read access to every credential of the database
+resource "devhub_querydesk_database_permission" "engineers" {
+  database_id = devhub_querydesk_database.example.id
+  role_id     = data.devhub_role.engineers.id
+  permission  = "read"
+}
+
+# allow a single user to approve queries for one credential
+resource "devhub_querydesk_database_permission" "oncall" {
+  database_id          = devhub_querydesk_database.example.id
+  credential_id        = devhub_querydesk_database.example.credential_ids["postgres"]
+  organization_user_id = data.devhub_user.oncall.id
+  permission           = "approve"
+}
@@ -0,0 +1 @@
+terraform import devhub_querydesk_saved_query.example <saved_query_id>
@@ -0,0 +1,11 @@
+data "devhub_role" "engineers" {
+  name = "Engineers"
+}
+
+resource "devhub_querydesk_saved_query" "example" {
+  title           = "Recent signups"
+  query           = "SELECT id, email, inserted_at FROM users ORDER BY inserted_at DESC LIMIT 100"
+  credential_id   = devhub_querydesk_database.example.credential_ids["postgres"]
+  shared_role_ids = [data.devhub_role.engineers.id]
+  tags            = ["users", "support"]
+}
@@ -0,0 +1 @@
+terraform import devhub_terradesk_policy_set.example <policy_set_id>
@@ -0,0 +1,25 @@
+resource "devhub_terradesk_policy_set" "inline" {
+  name              = "no-deletes"
+  enforcement_level = "mandatory"
+  workspace_ids     = [devhub_terradesk_workspace.example.id]
+
+  policies = {
+    "main.rego" = <<-EOT
+      package terraform.policies
+
+      deny contains msg if {
+        some change in input.resource_changes
+        "delete" in change.change.actions
+        msg := sprintf("%s can't be deleted", [change.address])
+      }
+    EOT
+  }
+}
+
+# Read the policies from a directory of a repository instead
+resource "devhub_terradesk_policy_set" "repository" {
+  name          = "security"
+  repository    = "devhub-tools/policies"
+  path          = "terraform/security"
+  workspace_ids = [devhub_terradesk_workspace.example.id]
+}
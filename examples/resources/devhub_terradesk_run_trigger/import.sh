@@ -0,0 +1 @@
+terraform import devhub_terradesk_run_trigger.example <run_trigger_id>
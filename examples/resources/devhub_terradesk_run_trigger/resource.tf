@@ -0,0 +1,19 @@
+resource "devhub_terradesk_workspace" "network" {
+  name         = "network"
+  repository   = "devhub-tools/infrastructure"
+  path         = "terraform/network"
+  docker_image = "hashicorp/terraform:1.10"
+}
+
+resource "devhub_terradesk_workspace" "app" {
+  name         = "app"
+  repository   = "devhub-tools/infrastructure"
+  path         = "terraform/app"
+  docker_image = "hashicorp/terraform:1.10"
+}
+
+# Plan the app workspace after every successful apply of the network workspace
+resource "devhub_terradesk_run_trigger" "network_to_app" {
+  source_workspace_id = devhub_terradesk_workspace.network.id
+  target_workspace_id = devhub_terradesk_workspace.app.id
+}
@@ -0,0 +1 @@
+terraform import devhub_terradesk_variable_set.example <variable_set_id>
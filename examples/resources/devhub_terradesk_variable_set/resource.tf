@@ -0,0 +1,30 @@
+resource "devhub_terradesk_variable_set" "example" {
+  name        = "gcp"
+  description = "Shared settings of workspaces deploying to GCP"
+
+  env_vars = {
+    TF_PLUGIN_CACHE_DIR = {
+      value = "/tmp/terraform-plugin-cache"
+    }
+    GOOGLE_REGION = {
+      value = "us-central1"
+    }
+  }
+
+  secrets = {
+    DATADOG_API_KEY = {
+      value_wo = var.datadog_api_key
+    }
+  }
+}
+
+resource "devhub_terradesk_workspace" "example" {
+  name         = "default"
+  repository   = "devhub-tools/devhub"
+  path         = "terraform"
+  docker_image = "hashicorp/terraform:1.10"
+
+  # env_vars and secrets of the workspace take precedence over the variable sets,
+  # later variable sets take precedence over earlier ones
+  variable_set_ids = [devhub_terradesk_variable_set.example.id]
+}
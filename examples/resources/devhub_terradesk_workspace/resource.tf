@@ -1,27 +1,90 @@
+data "devhub_role" "platform" {
+  name = "Platform"
+}
+
 resource "devhub_terradesk_workspace" "example" {
   name         = "default"
   repository   = "devhub-tools/devhub"
   path         = "terraform"
   docker_image = "hashicorp/terraform:1.10"
 
-  env_vars = [
+  cpu_requests         = "500m"
+  memory_requests      = "1Gi"
+  cpu_limits           = "2"
+  memory_limits        = "4Gi"
+  ephemeral_storage    = "10Gi"
+  service_account_name = "terraform"
+
+  node_selector = {
+    pool = "terraform"
+  }
+
+  tolerations = [
     {
-      name  = "ENV_VAR"
-      value = "env-var-value"
+      key    = "dedicated"
+      value  = "terraform"
+      effect = "NoSchedule"
     }
   ]
 
-  secrets = [
-    {
-      name  = "my_secret"
+  vcs_trigger = {
+    branch              = "main"
+    trigger_paths       = ["terraform/**", "modules/**"]
+    auto_apply_on_merge = true
+    required_checks     = ["lint", "test"]
+  }
+
+  approval_policy = {
+    required_approvals         = 1
+    destroy_required_approvals = 2
+
+    approvers = [
+      {
+        role_id = data.devhub_role.platform.id
+      }
+    ]
+  }
+
+  drift_detection = {
+    cron_schedule       = "0 6 * * *"
+    slack_channel       = "#infra"
+    create_linear_issue = true
+  }
+
+  notifications = {
+    plan_needs_approval = {
+      slack_channel = "#infra"
+    }
+
+    apply_failed = {
+      slack_channel = "#infra"
+      webhook_url   = "https://example.com/hooks/terradesk"
+    }
+  }
+
+  env_vars = {
+    ENV_VAR = {
+      value = "env-var-value"
+    }
+  }
+
+  secrets = {
+    my_secret = {
       value = "secret-value"
     }
-  ]
+    my_write_only_secret = {
+      value_wo = var.my_write_only_secret
+    }
+  }
+
+  # other workspaces allowed to read the outputs with devhub_terradesk_workspace_outputs
+  remote_state_consumer_ids = [devhub_terradesk_workspace.opentofu.id]
 
   workload_identity = {
-    enabled               = true
-    service_account_email = "devhub@my-project.iam.gserviceaccount.com"
-    provider              = google_iam_workload_identity_pool_provider.devhub.name
+    gcp = {
+      service_account_email = "devhub@my-project.iam.gserviceaccount.com"
+      provider              = google_iam_workload_identity_pool_provider.devhub.name
+    }
   }
 }
 
@@ -45,3 +108,40 @@ resource "google_iam_workload_identity_pool_provider" "devhub" {
     issuer_uri = "https://devhub.example.com"
   }
 }
+
+resource "devhub_terradesk_workspace" "aws" {
+  name         = "aws"
+  repository   = "devhub-tools/devhub"
+  path         = "terraform/aws"
+  docker_image = "hashicorp/terraform:1.10"
+
+  workload_identity = {
+    aws = {
+      role_arn         = "arn:aws:iam::123456789012:role/devhub"
+      session_duration = "1h"
+    }
+  }
+}
+
+resource "devhub_terradesk_workspace" "azure" {
+  name         = "azure"
+  repository   = "devhub-tools/devhub"
+  path         = "terraform/azure"
+  docker_image = "hashicorp/terraform:1.10"
+
+  workload_identity = {
+    azure = {
+      client_id = "00000000-0000-0000-0000-000000000001"
+      tenant_id = "00000000-0000-0000-0000-000000000002"
+    }
+  }
+}
+
+# DevHub picks the runner image when docker_image isn't set
+resource "devhub_terradesk_workspace" "opentofu" {
+  name              = "opentofu"
+  repository        = "devhub-tools/devhub"
+  path              = "tofu"
+  engine            = "opentofu"
+  terraform_version = "~> 1.9.0"
+}
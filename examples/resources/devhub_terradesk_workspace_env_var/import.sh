@@ -0,0 +1 @@
+terraform import devhub_terradesk_workspace_env_var.example <workspace_id>/<NAME>
@@ -0,0 +1,15 @@
+resource "devhub_terradesk_workspace" "example" {
+  name         = "default"
+  repository   = "devhub-tools/devhub"
+  path         = "terraform"
+  docker_image = "hashicorp/terraform:1.10"
+
+  # leave env vars managed with devhub_terradesk_workspace_env_var untouched
+  authoritative_env_vars = false
+}
+
+resource "devhub_terradesk_workspace_env_var" "example" {
+  workspace_id = devhub_terradesk_workspace.example.id
+  name         = "TF_LOG"
+  value        = "info"
+}
@@ -0,0 +1 @@
+terraform import devhub_terradesk_workspace_lock.example <workspace_id>
@@ -0,0 +1,4 @@
+resource "devhub_terradesk_workspace_lock" "freeze" {
+  workspace_id = devhub_terradesk_workspace.example.id
+  reason       = "Incident freeze, see #incident-123"
+}
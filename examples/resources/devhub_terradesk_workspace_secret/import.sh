@@ -0,0 +1,2 @@
+# Secret values are never returned by the API, the value is set again on the next apply.
+terraform import devhub_terradesk_workspace_secret.example <workspace_id>/<NAME>
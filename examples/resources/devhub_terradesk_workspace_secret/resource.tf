@@ -0,0 +1,23 @@
+resource "devhub_terradesk_workspace" "example" {
+  name         = "default"
+  repository   = "devhub-tools/devhub"
+  path         = "terraform"
+  docker_image = "hashicorp/terraform:1.10"
+
+  # leave secrets managed with devhub_terradesk_workspace_secret untouched
+  authoritative_secrets = false
+}
+
+resource "devhub_terradesk_workspace_secret" "example" {
+  workspace_id = devhub_terradesk_workspace.example.id
+  name         = "datadog_api_key"
+  value        = var.datadog_api_key
+}
+
+# with Terraform 1.11 or later the value can be kept out of state
+resource "devhub_terradesk_workspace_secret" "write_only" {
+  workspace_id     = devhub_terradesk_workspace.example.id
+  name             = "github_token"
+  value_wo         = var.github_token
+  value_wo_version = 1
+}
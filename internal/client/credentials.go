@@ -0,0 +1,55 @@
+package devhub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetDatabaseCredential(credentialId string) (*DatabaseCredential, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/querydesk/credentials/%s", c.HostURL, credentialId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	credential := DatabaseCredential{}
+	err = json.Unmarshal(body, &credential)
+	if err != nil {
+		return nil, err
+	}
+
+	return &credential, nil
+}
+
+// UpdateDatabaseCredentialPassword changes only the password of a credential, leaving the
+// rest of the database untouched.
+func (c *Client) UpdateDatabaseCredentialPassword(credentialId string, password string) (*DatabaseCredential, error) {
+	rb, err := json.Marshal(map[string]string{"password": password})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/v1/querydesk/credentials/%s", c.HostURL, credentialId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	credential := DatabaseCredential{}
+	err = json.Unmarshal(body, &credential)
+	if err != nil {
+		return nil, err
+	}
+
+	return &credential, nil
+}
@@ -0,0 +1,91 @@
+package devhub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetDatabasePermission(databaseId string, permissionId string) (*Permission, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/querydesk/databases/%s/permissions/%s", c.HostURL, databaseId, permissionId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	permission := Permission{}
+	err = json.Unmarshal(body, &permission)
+	if err != nil {
+		return nil, err
+	}
+
+	return &permission, nil
+}
+
+func (c *Client) CreateDatabasePermission(databaseId string, input Permission) (*Permission, error) {
+	rb, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/querydesk/databases/%s/permissions", c.HostURL, databaseId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	permission := Permission{}
+	err = json.Unmarshal(body, &permission)
+	if err != nil {
+		return nil, err
+	}
+
+	return &permission, nil
+}
+
+func (c *Client) UpdateDatabasePermission(databaseId string, permissionId string, input Permission) (*Permission, error) {
+	rb, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/v1/querydesk/databases/%s/permissions/%s", c.HostURL, databaseId, permissionId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	permission := Permission{}
+	err = json.Unmarshal(body, &permission)
+	if err != nil {
+		return nil, err
+	}
+
+	return &permission, nil
+}
+
+func (c *Client) DeleteDatabasePermission(databaseId string, permissionId string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/querydesk/databases/%s/permissions/%s", c.HostURL, databaseId, permissionId), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		return err
+	}
+
+	return nil
+}
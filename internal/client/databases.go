@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 )
 
@@ -27,6 +28,60 @@ func (c *Client) GetDatabase(databaseId string) (*Database, error) {
 	return &database, nil
 }
 
+func (c *Client) GetDatabaseByName(name string) (*Database, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/querydesk/databases/lookup?name=%s", c.HostURL, url.QueryEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	database := Database{}
+	err = json.Unmarshal(body, &database)
+	if err != nil {
+		return nil, err
+	}
+
+	return &database, nil
+}
+
+func (c *Client) ListDatabases(filter DatabaseFilter) ([]Database, error) {
+	query := url.Values{}
+
+	if filter.Group != "" {
+		query.Set("group", filter.Group)
+	}
+
+	if filter.Adapter != "" {
+		query.Set("adapter", filter.Adapter)
+	}
+
+	if filter.AgentId != "" {
+		query.Set("agent_id", filter.AgentId)
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/querydesk/databases?%s", c.HostURL, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	databases := []Database{}
+	err = json.Unmarshal(body, &databases)
+	if err != nil {
+		return nil, err
+	}
+
+	return databases, nil
+}
+
 func (c *Client) CreateDatabase(input Database) (*Database, error) {
 	rb, err := json.Marshal(input)
 	if err != nil {
@@ -89,3 +144,23 @@ func (c *Client) DeleteDatabase(databaseId string) error {
 
 	return nil
 }
+
+func (c *Client) TestDatabaseConnection(databaseId string, credentialId string) (*DatabaseConnectionCheck, error) {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/querydesk/databases/%s/credentials/%s/test_connection", c.HostURL, databaseId, credentialId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	check := DatabaseConnectionCheck{}
+	err = json.Unmarshal(body, &check)
+	if err != nil {
+		return nil, err
+	}
+
+	return &check, nil
+}
@@ -1,55 +1,200 @@
 package devhub
 
+import "encoding/json"
+
 type Database struct {
-	Id             string               `json:"id"`
-	Name           string               `json:"name"`
-	Adapter        string               `json:"adapter"`
-	Hostname       string               `json:"hostname"`
-	Port           *int64               `json:"port"`
-	Database       string               `json:"database"`
-	Ssl            bool                 `json:"ssl"`
-	Cacertfile     string               `json:"cacertfile"`
-	Keyfile        string               `json:"keyfile"`
-	Certfile       string               `json:"certfile"`
-	RestrictAccess bool                 `json:"restrict_access"`
-	Group          string               `json:"group"`
-	SlackChannel   string               `json:"slack_channel"`
-	AgentId        string               `json:"agent_id"`
-	Credentials    []DatabaseCredential `json:"credentials"`
+	Id         string `json:"id"`
+	Name       string `json:"name"`
+	Adapter    string `json:"adapter"`
+	Hostname   string `json:"hostname"`
+	Port       *int64 `json:"port"`
+	Database   string `json:"database"`
+	Ssl        bool   `json:"ssl"`
+	Cacertfile string `json:"cacertfile"`
+	Keyfile    string `json:"keyfile"`
+	Certfile   string `json:"certfile"`
+	// SHA256 fingerprints of the DER encoded PEM files, the files themselves are never returned
+	CacertfileFingerprint string               `json:"cacertfile_fingerprint,omitempty"`
+	KeyfileFingerprint    string               `json:"keyfile_fingerprint,omitempty"`
+	CertfileFingerprint   string               `json:"certfile_fingerprint,omitempty"`
+	CertfileNotAfter      string               `json:"certfile_not_after,omitempty"`
+	RestrictAccess        bool                 `json:"restrict_access"`
+	Group                 string               `json:"group"`
+	SlackChannel          string               `json:"slack_channel"`
+	AgentId               string               `json:"agent_id"`
+	SshTunnel             *DatabaseSshTunnel   `json:"ssh_tunnel"`
+	Credentials           []DatabaseCredential `json:"credentials"`
+}
+
+type DatabaseSshTunnel struct {
+	Host                 string `json:"host"`
+	Port                 int64  `json:"port"`
+	User                 string `json:"user"`
+	PrivateKey           string `json:"private_key"`
+	KnownHostFingerprint string `json:"known_host_fingerprint"`
 }
 
 type DatabaseCredential struct {
-	Id                string `json:"id"`
-	Username          string `json:"username"`
-	Password          string `json:"password"`
-	Hostname          string `json:"hostname"`
-	ReviewsRequired   int    `json:"reviews_required"`
-	DefaultCredential bool   `json:"default_credential"`
+	Id                string                          `json:"id"`
+	Username          string                          `json:"username"`
+	Password          string                          `json:"password"`
+	Hostname          string                          `json:"hostname"`
+	ReviewsRequired   int                             `json:"reviews_required"`
+	DefaultCredential bool                            `json:"default_credential"`
+	ReviewPolicy      *DatabaseCredentialReviewPolicy `json:"review_policy"`
+}
+
+type DatabaseCredentialReviewPolicy struct {
+	RequiredReviewerRoleIds []string `json:"required_reviewer_role_ids"`
+	AllowSelfApproval       bool     `json:"allow_self_approval"`
+	AutoApproveReadOnly     bool     `json:"auto_approve_read_only"`
+	// Null when approvals don't expire
+	ReviewExpirySeconds *int64 `json:"review_expiry_seconds"`
+}
+
+type DatabaseFilter struct {
+	Group   string
+	Adapter string
+	AgentId string
+}
+
+type DatabaseConnectionCheck struct {
+	Status    string `json:"status"`     // ok, error
+	ErrorType string `json:"error_type"` // dns, auth, tls, timeout, unknown
+	Message   string `json:"message"`
+	CheckedAt string `json:"checked_at"`
+}
+
+type SavedQuery struct {
+	Id            string   `json:"id"`
+	Title         string   `json:"title"`
+	Query         string   `json:"query"`
+	CredentialId  string   `json:"credential_id"`
+	SharedRoleIds []string `json:"shared_role_ids"`
+	Tags          []string `json:"tags"`
 }
 
 type TerradeskWorkspace struct {
-	Id                    string            `json:"id"`
-	Name                  string            `json:"name"`
-	Repository            string            `json:"repository"`
-	InitArgs              string            `json:"init_args"`
-	Path                  string            `json:"path"`
-	RunPlansAutomatically bool              `json:"run_plans_automatically"`
-	RequiredApprovals     int               `json:"required_approvals"`
-	DockerImage           string            `json:"docker_image"`
-	CpuRequests           string            `json:"cpu_requests"`
-	MemoryRequests        string            `json:"memory_requests"`
-	AgentId               string            `json:"agent_id"`
-	WorkloadIdentity      *WorkloadIdentity `json:"workload_identity"`
-	EnvVars               []EnvVar          `json:"env_vars"`
-	Secrets               []Secret          `json:"secrets"`
+	Id                     string                  `json:"id"`
+	Name                   string                  `json:"name"`
+	Repository             string                  `json:"repository"`
+	InitArgs               string                  `json:"init_args"`
+	Path                   string                  `json:"path"`
+	RunPlansAutomatically  bool                    `json:"run_plans_automatically"`
+	RequiredApprovals      int                     `json:"required_approvals"`
+	ApprovalPolicy         *ApprovalPolicy         `json:"approval_policy"`
+	DockerImage            string                  `json:"docker_image"` // picked by DevHub from the engine and terraform version when empty
+	Engine                 string                  `json:"engine"`
+	TerraformVersion       string                  `json:"terraform_version"`
+	CpuRequests            string                  `json:"cpu_requests"`
+	MemoryRequests         string                  `json:"memory_requests"`
+	CpuLimits              string                  `json:"cpu_limits"`
+	MemoryLimits           string                  `json:"memory_limits"`
+	EphemeralStorage       string                  `json:"ephemeral_storage"`
+	NodeSelector           map[string]string       `json:"node_selector"`
+	Tolerations            []Toleration            `json:"tolerations"`
+	ServiceAccountName     string                  `json:"service_account_name"`
+	AgentId                string                  `json:"agent_id"`
+	WorkloadIdentity       *WorkloadIdentity       `json:"workload_identity"`
+	VcsTrigger             *VcsTrigger             `json:"vcs_trigger"`
+	DriftDetection         *DriftDetection         `json:"drift_detection"`
+	Notifications          *WorkspaceNotifications `json:"notifications"`
+	EnvVars                []EnvVar                `json:"env_vars"`
+	Secrets                []Secret                `json:"secrets"`
+	VariableSetIds         []string                `json:"variable_set_ids"` // later sets take precedence, the workspace env vars and secrets over all sets
+	GlobalRemoteState      bool                    `json:"global_remote_state"`
+	RemoteStateConsumerIds []string                `json:"remote_state_consumer_ids"`
+	Lock                   *WorkspaceLock          `json:"lock,omitempty"` // read only, managed with the lock endpoints
+}
+
+type WorkspaceLock struct {
+	Id       string `json:"id"`
+	Reason   string `json:"reason"`
+	LockedBy string `json:"locked_by"`
+	LockedAt string `json:"locked_at"`
+}
+
+// WorkspaceOutput is an output of the latest successful apply of a workspace.
+type WorkspaceOutput struct {
+	Name      string          `json:"name"`
+	Value     json.RawMessage `json:"value"`
+	Sensitive bool            `json:"sensitive"`
+}
+
+type WorkspaceFilter struct {
+	Repository string
+	PathPrefix string
+	AgentId    string
+}
+
+type TerradeskWorkspacePage struct {
+	Workspaces []TerradeskWorkspace `json:"workspaces"`
+	NextCursor string               `json:"next_cursor"`
+}
+
+type ApprovalPolicy struct {
+	RequiredApprovals         int           `json:"required_approvals"`
+	DestroyRequiredApprovals  *int          `json:"destroy_required_approvals"` // uses RequiredApprovals when nil
+	AllowCommitAuthorApproval bool          `json:"allow_commit_author_approval"`
+	Permissions               []*Permission `json:"permissions"`
+}
+
+type Toleration struct {
+	Key               string `json:"key"`
+	Operator          string `json:"operator"`
+	Value             string `json:"value"`
+	Effect            string `json:"effect"`
+	TolerationSeconds *int64 `json:"toleration_seconds"`
 }
 
+// WorkloadIdentity has exactly one of the cloud specific settings set.
 type WorkloadIdentity struct {
-	Enabled             bool   `json:"enabled"`
+	Gcp   *GcpWorkloadIdentity   `json:"gcp,omitempty"`
+	Aws   *AwsWorkloadIdentity   `json:"aws,omitempty"`
+	Azure *AzureWorkloadIdentity `json:"azure,omitempty"`
+}
+
+type GcpWorkloadIdentity struct {
 	ServiceAccountEmail string `json:"service_account_email"`
 	Provider            string `json:"provider"`
 }
 
+type AwsWorkloadIdentity struct {
+	RoleArn                string `json:"role_arn"`
+	SessionDurationSeconds *int64 `json:"session_duration_seconds"`
+}
+
+type AzureWorkloadIdentity struct {
+	ClientId string `json:"client_id"`
+	TenantId string `json:"tenant_id"`
+}
+
+type VcsTrigger struct {
+	// Empty for the default branch of the repository
+	Branch           string   `json:"branch"`
+	TriggerPaths     []string `json:"trigger_paths"`
+	SpeculativePlans bool     `json:"speculative_plans"`
+	AutoApplyOnMerge bool     `json:"auto_apply_on_merge"`
+	RequiredChecks   []string `json:"required_checks"`
+}
+
+type DriftDetection struct {
+	CronSchedule      string `json:"cron_schedule"`
+	SlackChannel      string `json:"slack_channel"`
+	CreateLinearIssue bool   `json:"create_linear_issue"`
+}
+
+type WorkspaceNotifications struct {
+	PlanNeedsApproval *NotificationTarget `json:"plan_needs_approval"`
+	ApplyFailed       *NotificationTarget `json:"apply_failed"`
+	DriftDetected     *NotificationTarget `json:"drift_detected"`
+}
+
+type NotificationTarget struct {
+	SlackChannel string `json:"slack_channel"`
+	WebhookUrl   string `json:"webhook_url"`
+}
+
 type EnvVar struct {
 	Id    string `json:"id"`
 	Name  string `json:"name"`
@@ -60,6 +205,33 @@ type Secret struct {
 	Id    string `json:"id"`
 	Name  string `json:"name"`
 	Value string `json:"value"`
+	// Returned instead of the value in the format `<salt>$<hex sha256 of salt and value>`
+	ValueHash string `json:"value_hash,omitempty"`
+}
+
+type PolicySet struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	// Rego files by file name, empty when the policies are read from a repository
+	Policies         map[string]string `json:"policies"`
+	Repository       string            `json:"repository"`
+	Path             string            `json:"path"`
+	EnforcementLevel string            `json:"enforcement_level"`
+	WorkspaceIds     []string          `json:"workspace_ids"`
+}
+
+type VariableSet struct {
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	EnvVars     []EnvVar `json:"env_vars"`
+	Secrets     []Secret `json:"secrets"`
+}
+
+type RunTrigger struct {
+	Id                string `json:"id"`
+	SourceWorkspaceId string `json:"source_workspace_id"`
+	TargetWorkspaceId string `json:"target_workspace_id"`
 }
 
 type Workflow struct {
@@ -153,6 +325,8 @@ type Permission struct {
 	Permission         string `json:"permission"`
 	RoleId             string `json:"role_id"`
 	OrganizationUserId string `json:"organization_user_id"`
+	// Only used for database permissions to scope the permission to a single credential
+	CredentialId string `json:"credential_id,omitempty"`
 }
 
 type Role struct {
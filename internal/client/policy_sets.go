@@ -0,0 +1,92 @@
+package devhub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) CreatePolicySet(input PolicySet) (*PolicySet, error) {
+	rb, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/terradesk/policy_sets", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var policySet PolicySet
+	err = json.Unmarshal(body, &policySet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policySet, nil
+}
+
+func (c *Client) GetPolicySet(id string) (*PolicySet, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/terradesk/policy_sets/%s", c.HostURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var policySet PolicySet
+	err = json.Unmarshal(body, &policySet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policySet, nil
+}
+
+func (c *Client) UpdatePolicySet(policySetId string, input PolicySet) (*PolicySet, error) {
+	rb, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/v1/terradesk/policy_sets/%s", c.HostURL, policySetId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var policySet PolicySet
+	err = json.Unmarshal(body, &policySet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policySet, nil
+}
+
+func (c *Client) DeletePolicySet(id string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/terradesk/policy_sets/%s", c.HostURL, id), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
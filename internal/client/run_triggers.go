@@ -0,0 +1,87 @@
+package devhub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) CreateRunTrigger(input RunTrigger) (*RunTrigger, error) {
+	rb, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/terradesk/run_triggers", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var runTrigger RunTrigger
+	err = json.Unmarshal(body, &runTrigger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runTrigger, nil
+}
+
+func (c *Client) GetRunTrigger(id string) (*RunTrigger, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/terradesk/run_triggers/%s", c.HostURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var runTrigger RunTrigger
+	err = json.Unmarshal(body, &runTrigger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runTrigger, nil
+}
+
+func (c *Client) ListRunTriggers() ([]RunTrigger, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/terradesk/run_triggers", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	runTriggers := []RunTrigger{}
+	err = json.Unmarshal(body, &runTriggers)
+	if err != nil {
+		return nil, err
+	}
+
+	return runTriggers, nil
+}
+
+func (c *Client) DeleteRunTrigger(id string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/terradesk/run_triggers/%s", c.HostURL, id), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
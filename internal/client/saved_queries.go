@@ -0,0 +1,92 @@
+package devhub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) CreateSavedQuery(input SavedQuery) (*SavedQuery, error) {
+	rb, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/querydesk/saved_queries", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var savedQuery SavedQuery
+	err = json.Unmarshal(body, &savedQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return &savedQuery, nil
+}
+
+func (c *Client) GetSavedQuery(id string) (*SavedQuery, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/querydesk/saved_queries/%s", c.HostURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var savedQuery SavedQuery
+	err = json.Unmarshal(body, &savedQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return &savedQuery, nil
+}
+
+func (c *Client) UpdateSavedQuery(savedQueryId string, input SavedQuery) (*SavedQuery, error) {
+	rb, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/v1/querydesk/saved_queries/%s", c.HostURL, savedQueryId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var savedQuery SavedQuery
+	err = json.Unmarshal(body, &savedQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return &savedQuery, nil
+}
+
+func (c *Client) DeleteSavedQuery(id string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/querydesk/saved_queries/%s", c.HostURL, id), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package devhub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) CreateVariableSet(input VariableSet) (*VariableSet, error) {
+	rb, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/terradesk/variable_sets", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var variableSet VariableSet
+	err = json.Unmarshal(body, &variableSet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &variableSet, nil
+}
+
+func (c *Client) GetVariableSet(id string) (*VariableSet, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/terradesk/variable_sets/%s", c.HostURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var variableSet VariableSet
+	err = json.Unmarshal(body, &variableSet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &variableSet, nil
+}
+
+func (c *Client) UpdateVariableSet(variableSetId string, input VariableSet) (*VariableSet, error) {
+	rb, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/v1/terradesk/variable_sets/%s", c.HostURL, variableSetId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var variableSet VariableSet
+	err = json.Unmarshal(body, &variableSet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &variableSet, nil
+}
+
+func (c *Client) DeleteVariableSet(id string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/terradesk/variable_sets/%s", c.HostURL, id), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package devhub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func (c *Client) GetWorkspaceEnvVar(workspaceId string, name string) (*EnvVar, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/terradesk/workspaces/%s/env_vars/%s", c.HostURL, workspaceId, url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	envVar := EnvVar{}
+	err = json.Unmarshal(body, &envVar)
+	if err != nil {
+		return nil, err
+	}
+
+	return &envVar, nil
+}
+
+func (c *Client) CreateWorkspaceEnvVar(workspaceId string, input EnvVar) (*EnvVar, error) {
+	rb, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/terradesk/workspaces/%s/env_vars", c.HostURL, workspaceId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	envVar := EnvVar{}
+	err = json.Unmarshal(body, &envVar)
+	if err != nil {
+		return nil, err
+	}
+
+	return &envVar, nil
+}
+
+func (c *Client) UpdateWorkspaceEnvVar(workspaceId string, name string, input EnvVar) (*EnvVar, error) {
+	rb, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/v1/terradesk/workspaces/%s/env_vars/%s", c.HostURL, workspaceId, url.PathEscape(name)), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	envVar := EnvVar{}
+	err = json.Unmarshal(body, &envVar)
+	if err != nil {
+		return nil, err
+	}
+
+	return &envVar, nil
+}
+
+func (c *Client) DeleteWorkspaceEnvVar(workspaceId string, name string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/terradesk/workspaces/%s/env_vars/%s", c.HostURL, workspaceId, url.PathEscape(name)), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package devhub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GetWorkspaceLock returns the current lock of the workspace or a "not found" error when it isn't locked.
+func (c *Client) GetWorkspaceLock(workspaceId string) (*WorkspaceLock, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/terradesk/workspaces/%s/lock", c.HostURL, workspaceId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var lock WorkspaceLock
+	err = json.Unmarshal(body, &lock)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lock, nil
+}
+
+func (c *Client) LockWorkspace(workspaceId string, input WorkspaceLock) (*WorkspaceLock, error) {
+	rb, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/terradesk/workspaces/%s/lock", c.HostURL, workspaceId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var lock WorkspaceLock
+	err = json.Unmarshal(body, &lock)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lock, nil
+}
+
+func (c *Client) UpdateWorkspaceLock(workspaceId string, input WorkspaceLock) (*WorkspaceLock, error) {
+	rb, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/v1/terradesk/workspaces/%s/lock", c.HostURL, workspaceId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var lock WorkspaceLock
+	err = json.Unmarshal(body, &lock)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lock, nil
+}
+
+// UnlockWorkspace removes the lock with the id lockId. DevHub rejects unlocking a lock taken
+// by a different user or API key unless force is set.
+func (c *Client) UnlockWorkspace(workspaceId string, lockId string, force bool) error {
+	query := url.Values{}
+	query.Set("lock_id", lockId)
+
+	if force {
+		query.Set("force", "true")
+	}
+
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/terradesk/workspaces/%s/lock?%s", c.HostURL, workspaceId, query.Encode()), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		return err
+	}
+
+	return nil
+}
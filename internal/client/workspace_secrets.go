@@ -0,0 +1,93 @@
+package devhub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GetWorkspaceSecret returns the secret without its value, secret values are never returned.
+func (c *Client) GetWorkspaceSecret(workspaceId string, name string) (*Secret, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/terradesk/workspaces/%s/secrets/%s", c.HostURL, workspaceId, url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := Secret{}
+	err = json.Unmarshal(body, &secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secret, nil
+}
+
+func (c *Client) CreateWorkspaceSecret(workspaceId string, input Secret) (*Secret, error) {
+	rb, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/terradesk/workspaces/%s/secrets", c.HostURL, workspaceId), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := Secret{}
+	err = json.Unmarshal(body, &secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secret, nil
+}
+
+func (c *Client) UpdateWorkspaceSecret(workspaceId string, name string, input Secret) (*Secret, error) {
+	rb, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/v1/terradesk/workspaces/%s/secrets/%s", c.HostURL, workspaceId, url.PathEscape(name)), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := Secret{}
+	err = json.Unmarshal(body, &secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secret, nil
+}
+
+func (c *Client) DeleteWorkspaceSecret(workspaceId string, name string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/terradesk/workspaces/%s/secrets/%s", c.HostURL, workspaceId, url.PathEscape(name)), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		return err
+	}
+
+	return nil
+}
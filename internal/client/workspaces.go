@@ -4,9 +4,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 )
 
+// workspacesPageSize is the number of workspaces requested per page when listing workspaces.
+const workspacesPageSize = 100
+
 func (c *Client) GetWorkspace(workspaceId string) (*TerradeskWorkspace, error) {
 	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/terradesk/workspaces/%s", c.HostURL, workspaceId), nil)
 	if err != nil {
@@ -27,6 +31,100 @@ func (c *Client) GetWorkspace(workspaceId string) (*TerradeskWorkspace, error) {
 	return &workspace, nil
 }
 
+func (c *Client) GetWorkspaceByName(name string) (*TerradeskWorkspace, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/terradesk/workspaces/lookup?name=%s", c.HostURL, url.QueryEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace := TerradeskWorkspace{}
+	err = json.Unmarshal(body, &workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workspace, nil
+}
+
+// ListWorkspaces returns all workspaces matching the filter, requesting pages until
+// there is no next cursor. A cursor returned twice is an error instead of a loop.
+func (c *Client) ListWorkspaces(filter WorkspaceFilter) ([]TerradeskWorkspace, error) {
+	query := url.Values{}
+	query.Set("page_size", fmt.Sprint(workspacesPageSize))
+
+	if filter.Repository != "" {
+		query.Set("repository", filter.Repository)
+	}
+
+	if filter.PathPrefix != "" {
+		query.Set("path_prefix", filter.PathPrefix)
+	}
+
+	if filter.AgentId != "" {
+		query.Set("agent_id", filter.AgentId)
+	}
+
+	workspaces := []TerradeskWorkspace{}
+	cursors := map[string]bool{}
+
+	for {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/terradesk/workspaces?%s", c.HostURL, query.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return nil, err
+		}
+
+		page := TerradeskWorkspacePage{}
+		err = json.Unmarshal(body, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		workspaces = append(workspaces, page.Workspaces...)
+
+		if page.NextCursor == "" {
+			return workspaces, nil
+		}
+
+		if cursors[page.NextCursor] {
+			return nil, fmt.Errorf("the next cursor %s was already requested", page.NextCursor)
+		}
+		cursors[page.NextCursor] = true
+
+		query.Set("cursor", page.NextCursor)
+	}
+}
+
+// GetWorkspaceOutputs returns the outputs of the latest successful apply of the workspace.
+func (c *Client) GetWorkspaceOutputs(workspaceId string) ([]WorkspaceOutput, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/terradesk/workspaces/%s/outputs", c.HostURL, workspaceId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var outputs []WorkspaceOutput
+	err = json.Unmarshal(body, &outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	return outputs, nil
+}
+
 func (c *Client) CreateWorkspace(input TerradeskWorkspace) (*TerradeskWorkspace, error) {
 	if input.EnvVars == nil {
 		input.EnvVars = make([]EnvVar, 0)
@@ -0,0 +1,82 @@
+package devhub
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestListWorkspaces(t *testing.T) {
+	testCases := map[string]struct {
+		pages         map[string]string
+		expectedIds   []string
+		expectedError string
+	}{
+		"single page": {
+			pages: map[string]string{
+				"": `{"workspaces":[{"id":"ws_1"},{"id":"ws_2"}],"next_cursor":""}`,
+			},
+			expectedIds: []string{"ws_1", "ws_2"},
+		},
+		"multiple pages": {
+			pages: map[string]string{
+				"":       `{"workspaces":[{"id":"ws_1"}],"next_cursor":"page_2"}`,
+				"page_2": `{"workspaces":[{"id":"ws_2"}],"next_cursor":"page_3"}`,
+				"page_3": `{"workspaces":[{"id":"ws_3"}]}`,
+			},
+			expectedIds: []string{"ws_1", "ws_2", "ws_3"},
+		},
+		"repeated cursor": {
+			pages: map[string]string{
+				"":       `{"workspaces":[{"id":"ws_1"}],"next_cursor":"page_2"}`,
+				"page_2": `{"workspaces":[{"id":"ws_2"}],"next_cursor":"page_2"}`,
+			},
+			expectedError: "the next cursor page_2 was already requested",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("repository") != "devhub-tools/devhub" {
+					t.Errorf("expected the repository filter, got: %s", r.URL.RawQuery)
+				}
+
+				page, ok := testCase.pages[r.URL.Query().Get("cursor")]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				fmt.Fprint(w, page)
+			}))
+			defer server.Close()
+
+			client := &Client{HostURL: server.URL, HTTPClient: server.Client()}
+
+			workspaces, err := client.ListWorkspaces(WorkspaceFilter{Repository: "devhub-tools/devhub"})
+
+			if testCase.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			ids := make([]string, 0, len(workspaces))
+			for _, workspace := range workspaces {
+				ids = append(ids, workspace.Id)
+			}
+
+			if strings.Join(ids, ",") != strings.Join(testCase.expectedIds, ",") {
+				t.Errorf("expected workspaces %v, got: %v", testCase.expectedIds, ids)
+			}
+		})
+	}
+}
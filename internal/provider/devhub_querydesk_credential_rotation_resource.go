@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	devhub "terraform-provider-devhub/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource               = &credentialRotationResource{}
+	_ resource.ResourceWithConfigure  = &credentialRotationResource{}
+	_ resource.ResourceWithModifyPlan = &credentialRotationResource{}
+)
+
+const passwordCharacters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+func CredentialRotationResource() resource.Resource {
+	return &credentialRotationResource{}
+}
+
+// credentialRotationResourceModel describes the resource data model.
+type credentialRotationResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	CredentialId   types.String `tfsdk:"credential_id"`
+	RotationPeriod types.String `tfsdk:"rotation_period"`
+	Keepers        types.Map    `tfsdk:"keepers"`
+	PasswordLength types.Int64  `tfsdk:"password_length"`
+	Password       types.String `tfsdk:"password"`
+	RotatedAt      types.String `tfsdk:"rotated_at"`
+}
+
+type credentialRotationResource struct {
+	client *devhub.Client
+}
+
+func (r *credentialRotationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_querydesk_credential_rotation"
+}
+
+func (r *credentialRotationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a new password for a QueryDesk credential and updates the credential in DevHub. " +
+			"The password is rotated when `rotation_period` has passed since the last rotation or when `keepers` change, and is exposed as `password` so it can be set on the database user. " +
+			"Set `password_wo` on the credential of the `devhub_querydesk_database` resource so it doesn't send its own password again.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Rotation id, the same as `credential_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"credential_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the credential to rotate the password of.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_period": schema.StringAttribute{
+				MarkdownDescription: "How long a password is used before it's rotated, for example `720h` for 30 days. The rotation is planned on the first plan after the period passed.",
+				Optional:            true,
+				Validators: []validator.String{
+					positiveDuration(),
+				},
+			},
+			"keepers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that trigger a rotation when changed.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"password_length": schema.Int64Attribute{
+				MarkdownDescription: "The length of the generated password.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(32),
+				Validators: []validator.Int64{
+					int64validator.Between(16, 128),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The generated password.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotated_at": schema.StringAttribute{
+				MarkdownDescription: "When the password was last rotated, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan plans a rotation once the rotation period has passed.
+func (r *credentialRotationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when creating or destroying
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan credentialRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state credentialRotationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RotationPeriod.IsNull() || plan.RotationPeriod.IsUnknown() {
+		return
+	}
+
+	rotationPeriod, err := time.ParseDuration(plan.RotationPeriod.ValueString())
+	if err != nil {
+		return
+	}
+
+	rotatedAt, err := time.Parse(time.RFC3339, state.RotatedAt.ValueString())
+	if err != nil {
+		return
+	}
+
+	if time.Now().Before(rotatedAt.Add(rotationPeriod)) {
+		return
+	}
+
+	plan.Password = types.StringUnknown()
+	plan.RotatedAt = types.StringUnknown()
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+func (r *credentialRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan credentialRotationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.rotate(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rotating credential password",
+			"Could not rotate password for credential "+plan.CredentialId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Id = plan.CredentialId
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *credentialRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state credentialRotationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The password can't be read back, only make sure the credential still exists
+	_, err := r.client.GetDatabaseCredential(state.CredentialId.ValueString())
+
+	if err != nil && err.Error() == "not found" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading credential",
+			"Could not read credential "+state.CredentialId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *credentialRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan credentialRotationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The password is only unknown when ModifyPlan planned a rotation, other changes
+	// like a new rotation_period don't need to touch the credential.
+	if plan.Password.IsUnknown() {
+		err := r.rotate(&plan)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error rotating credential password",
+				"Could not rotate password for credential "+plan.CredentialId.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete only removes the rotation from state, the credential keeps its current password.
+func (r *credentialRotationResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *credentialRotationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*devhub.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *devhub.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// rotate generates a new password, saves it to the credential and stores it on the model.
+func (r *credentialRotationResource) rotate(plan *credentialRotationResourceModel) error {
+	password, err := generatePassword(int(plan.PasswordLength.ValueInt64()))
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.UpdateDatabaseCredentialPassword(plan.CredentialId.ValueString(), password)
+	if err != nil {
+		return err
+	}
+
+	plan.Password = types.StringValue(password)
+	plan.RotatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	return nil
+}
+
+func generatePassword(length int) (string, error) {
+	password := make([]byte, length)
+	characters := big.NewInt(int64(len(passwordCharacters)))
+
+	for i := range password {
+		n, err := rand.Int(rand.Reader, characters)
+		if err != nil {
+			return "", err
+		}
+
+		password[i] = passwordCharacters[n.Int64()]
+	}
+
+	return string(password), nil
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccCredentialRotationResource(t *testing.T) {
+	name := fmt.Sprintf("database_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCredentialRotationResourceConfig(name, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("devhub_querydesk_credential_rotation.test", "credential_id", "devhub_querydesk_database.test", "credential_ids.postgres"),
+					resource.TestCheckResourceAttrPair("devhub_querydesk_credential_rotation.test", "id", "devhub_querydesk_database.test", "credential_ids.postgres"),
+					resource.TestCheckResourceAttr("devhub_querydesk_credential_rotation.test", "rotation_period", "720h"),
+					resource.TestCheckResourceAttr("devhub_querydesk_credential_rotation.test", "password_length", "32"),
+					resource.TestMatchResourceAttr("devhub_querydesk_credential_rotation.test", "password", regexp.MustCompile(`^[a-zA-Z0-9]{32}$`)),
+					resource.TestCheckResourceAttrSet("devhub_querydesk_credential_rotation.test", "rotated_at"),
+				),
+			},
+			// Changing a keeper rotates the password
+			{
+				Config: testAccCredentialRotationResourceConfig(name, "second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("devhub_querydesk_credential_rotation.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_querydesk_credential_rotation.test", "keepers.version", "second"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCredentialRotationResourceConfig(name string, keeper string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_querydesk_database" "test" {
+  name     = %[1]q
+  adapter  = "POSTGRES"
+  hostname = "localhost"
+  database = "mydb"
+
+	credentials = [
+		{
+			username            = "postgres"
+			password_wo         = "password"
+			password_wo_version = 1
+			reviews_required    = 0
+		}
+	]
+}
+
+resource "devhub_querydesk_credential_rotation" "test" {
+	credential_id   = devhub_querydesk_database.test.credential_ids["postgres"]
+	rotation_period = "720h"
+
+	keepers = {
+		version = %[2]q
+	}
+}
+`, name, keeper)
+}
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	devhub "terraform-provider-devhub/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &databaseDataSource{}
+	_ datasource.DataSourceWithConfigure = &databaseDataSource{}
+)
+
+func NewDatabaseDataSource() datasource.DataSource {
+	return &databaseDataSource{}
+}
+
+type databaseDataSource struct {
+	client *devhub.Client
+}
+
+type databaseDataSourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Adapter        types.String `tfsdk:"adapter"`
+	Hostname       types.String `tfsdk:"hostname"`
+	Port           types.Int64  `tfsdk:"port"`
+	Database       types.String `tfsdk:"database"`
+	Ssl            types.Bool   `tfsdk:"ssl"`
+	RestrictAccess types.Bool   `tfsdk:"restrict_access"`
+	Group          types.String `tfsdk:"group"`
+	SlackChannel   types.String `tfsdk:"slack_channel"`
+	AgentId        types.String `tfsdk:"agent_id"`
+	CredentialIds  types.Map    `tfsdk:"credential_ids"`
+}
+
+func (d *databaseDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_querydesk_database"
+}
+
+func (d *databaseDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := databaseDataSourceAttributes()
+
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Database id, either `id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRoot("id"),
+				path.MatchRoot("name"),
+			),
+		},
+	}
+
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the database, either `id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a QueryDesk database by id or name, for example to use the credential ids of a database managed elsewhere.",
+		Attributes:          attributes,
+	}
+}
+
+// databaseDataSourceAttributes returns the attributes shared by the database and databases data sources.
+func databaseDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Database id.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the database.",
+			Computed:            true,
+		},
+		"adapter": schema.StringAttribute{
+			MarkdownDescription: "The adapter used to establish the connection.",
+			Computed:            true,
+		},
+		"hostname": schema.StringAttribute{
+			MarkdownDescription: "The hostname for connecting to the database.",
+			Computed:            true,
+		},
+		"port": schema.Int64Attribute{
+			MarkdownDescription: "The port to connect to the database on, null when using the default port.",
+			Computed:            true,
+		},
+		"database": schema.StringAttribute{
+			MarkdownDescription: "The name of the database to connect to.",
+			Computed:            true,
+		},
+		"ssl": schema.BoolAttribute{
+			MarkdownDescription: "Whether ssl connections are used for this database.",
+			Computed:            true,
+		},
+		"restrict_access": schema.BoolAttribute{
+			MarkdownDescription: "Whether access to this database has to be explicitly granted.",
+			Computed:            true,
+		},
+		"group": schema.StringAttribute{
+			MarkdownDescription: "The group this database belongs to.",
+			Computed:            true,
+		},
+		"slack_channel": schema.StringAttribute{
+			MarkdownDescription: "The slack channel query request notifications are sent to.",
+			Computed:            true,
+		},
+		"agent_id": schema.StringAttribute{
+			MarkdownDescription: "The agent id for the database.",
+			Computed:            true,
+		},
+		"credential_ids": schema.MapAttribute{
+			MarkdownDescription: "A map of credential IDs by username.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+	}
+}
+
+func newDatabaseDataSourceModel(database *devhub.Database) databaseDataSourceModel {
+	model := databaseDataSourceModel{
+		Id:             types.StringValue(database.Id),
+		Name:           types.StringValue(database.Name),
+		Adapter:        types.StringValue(strings.ToUpper(database.Adapter)),
+		Hostname:       types.StringValue(database.Hostname),
+		Port:           types.Int64Null(),
+		Database:       types.StringValue(database.Database),
+		Ssl:            types.BoolValue(database.Ssl),
+		RestrictAccess: types.BoolValue(database.RestrictAccess),
+		Group:          types.StringNull(),
+		SlackChannel:   types.StringNull(),
+		AgentId:        types.StringNull(),
+	}
+
+	if database.Port != nil {
+		model.Port = types.Int64Value(*database.Port)
+	}
+
+	if database.Group != "" {
+		model.Group = types.StringValue(database.Group)
+	}
+
+	if database.SlackChannel != "" {
+		model.SlackChannel = types.StringValue(database.SlackChannel)
+	}
+
+	if database.AgentId != "" {
+		model.AgentId = types.StringValue(database.AgentId)
+	}
+
+	credentialIds := make(map[string]attr.Value)
+
+	for _, credential := range database.Credentials {
+		credentialIds[credential.Username] = types.StringValue(credential.Id)
+	}
+
+	model.CredentialIds = types.MapValueMust(types.StringType, credentialIds)
+
+	return model
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *databaseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config databaseDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var database *devhub.Database
+	var err error
+
+	if config.Id.ValueString() != "" {
+		database, err = d.client.GetDatabase(config.Id.ValueString())
+	} else {
+		database, err = d.client.GetDatabaseByName(config.Name.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Database",
+			fmt.Sprintf("Could not read database: %s", err.Error()),
+		)
+		return
+	}
+
+	state := newDatabaseDataSourceModel(database)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *databaseDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*devhub.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *devhub.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
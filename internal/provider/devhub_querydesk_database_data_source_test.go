@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatabaseDataSource(t *testing.T) {
+	name := fmt.Sprintf("database_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Lookup by name
+			{
+				Config: testAccDatabaseDataSourceConfig(name, `name = devhub_querydesk_database.test.name`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.devhub_querydesk_database.test", "id", "devhub_querydesk_database.test", "id"),
+					resource.TestCheckResourceAttr("data.devhub_querydesk_database.test", "name", name),
+					resource.TestCheckResourceAttr("data.devhub_querydesk_database.test", "adapter", "POSTGRES"),
+					resource.TestCheckResourceAttr("data.devhub_querydesk_database.test", "hostname", "localhost"),
+					resource.TestCheckResourceAttr("data.devhub_querydesk_database.test", "database", "mydb"),
+					resource.TestCheckResourceAttr("data.devhub_querydesk_database.test", "group", "data-source-test"),
+					resource.TestCheckResourceAttrPair("data.devhub_querydesk_database.test", "credential_ids.postgres", "devhub_querydesk_database.test", "credential_ids.postgres"),
+				),
+			},
+			// Lookup by id
+			{
+				Config: testAccDatabaseDataSourceConfig(name, `id = devhub_querydesk_database.test.id`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.devhub_querydesk_database.test", "id", "devhub_querydesk_database.test", "id"),
+					resource.TestCheckResourceAttr("data.devhub_querydesk_database.test", "name", name),
+				),
+			},
+			// Unknown databases fail
+			{
+				Config:      testAccDatabaseDataSourceConfig(name, fmt.Sprintf(`name = "%s_missing"`, name)),
+				ExpectError: regexp.MustCompile("Error Reading Database"),
+			},
+		},
+	})
+}
+
+func testAccDatabaseDataSourceConfig(name string, lookup string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_querydesk_database" "test" {
+  name     = %[1]q
+  adapter  = "POSTGRES"
+  hostname = "localhost"
+  database = "mydb"
+  group    = "data-source-test"
+
+	credentials = [
+		{
+			username = "postgres"
+			password = "password"
+			reviews_required = 0
+			default_credential = true
+		}
+	]
+}
+
+data "devhub_querydesk_database" "test" {
+	%[2]s
+}
+`, name, lookup)
+}
@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	devhub "terraform-provider-devhub/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &databasePermissionResource{}
+	_ resource.ResourceWithConfigure   = &databasePermissionResource{}
+	_ resource.ResourceWithImportState = &databasePermissionResource{}
+)
+
+func DatabasePermissionResource() resource.Resource {
+	return &databasePermissionResource{}
+}
+
+// databasePermissionResourceModel describes the resource data model.
+type databasePermissionResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	DatabaseId         types.String `tfsdk:"database_id"`
+	CredentialId       types.String `tfsdk:"credential_id"`
+	Permission         types.String `tfsdk:"permission"`
+	RoleId             types.String `tfsdk:"role_id"`
+	OrganizationUserId types.String `tfsdk:"organization_user_id"`
+}
+
+type databasePermissionResource struct {
+	client *devhub.Client
+}
+
+func (r *databasePermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_querydesk_database_permission"
+}
+
+func (r *databasePermissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Grants a role or user access to a database, or to a single credential of a database. Used to give access to databases with `restrict_access` enabled.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Permission id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the database to grant access to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"credential_id": schema.StringAttribute{
+				MarkdownDescription: "Limit the permission to a single credential of the database, if not set the permission applies to all credentials.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission": schema.StringAttribute{
+				MarkdownDescription: "The permission granted to the role or user: `read`, `write` or `approve`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"read",
+						"write",
+						"approve",
+					),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the role.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("role_id"),
+						path.MatchRoot("organization_user_id"),
+					),
+				},
+			},
+			"organization_user_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the organization user.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *databasePermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan databasePermissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := devhub.Permission{
+		Permission:         plan.Permission.ValueString(),
+		RoleId:             plan.RoleId.ValueString(),
+		OrganizationUserId: plan.OrganizationUserId.ValueString(),
+		CredentialId:       plan.CredentialId.ValueString(),
+	}
+
+	permission, err := r.client.CreateDatabasePermission(plan.DatabaseId.ValueString(), input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating database permission",
+			"Could not create database permission, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(permission.Id)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *databasePermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state databasePermissionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permission, err := r.client.GetDatabasePermission(state.DatabaseId.ValueString(), state.Id.ValueString())
+
+	if err != nil && err.Error() == "not found" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading database permission",
+			"Could not read database permission "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Id = types.StringValue(permission.Id)
+	state.Permission = types.StringValue(permission.Permission)
+
+	state.CredentialId = types.StringNull()
+	state.RoleId = types.StringNull()
+	state.OrganizationUserId = types.StringNull()
+
+	if permission.CredentialId != "" {
+		state.CredentialId = types.StringValue(permission.CredentialId)
+	}
+
+	if permission.RoleId != "" {
+		state.RoleId = types.StringValue(permission.RoleId)
+	}
+
+	if permission.OrganizationUserId != "" {
+		state.OrganizationUserId = types.StringValue(permission.OrganizationUserId)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *databasePermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan databasePermissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := devhub.Permission{
+		Id:                 plan.Id.ValueString(),
+		Permission:         plan.Permission.ValueString(),
+		RoleId:             plan.RoleId.ValueString(),
+		OrganizationUserId: plan.OrganizationUserId.ValueString(),
+		CredentialId:       plan.CredentialId.ValueString(),
+	}
+
+	_, err := r.client.UpdateDatabasePermission(plan.DatabaseId.ValueString(), plan.Id.ValueString(), input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating database permission",
+			"Could not update database permission, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *databasePermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state databasePermissionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDatabasePermission(state.DatabaseId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting database permission",
+			"Could not delete database permission, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *databasePermissionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*devhub.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *devhub.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState expects an id in the format `database_id/permission_id`.
+func (r *databasePermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	databaseId, permissionId, found := strings.Cut(req.ID, "/")
+
+	if !found || databaseId == "" || permissionId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: database_id/permission_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database_id"), databaseId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), permissionId)...)
+}
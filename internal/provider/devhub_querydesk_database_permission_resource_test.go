@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDatabasePermissionResource(t *testing.T) {
+	name := fmt.Sprintf("database_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDatabasePermissionResourceConfig(name, "read"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("devhub_querydesk_database_permission.role", "database_id", "devhub_querydesk_database.test", "id"),
+					resource.TestCheckResourceAttrPair("devhub_querydesk_database_permission.role", "role_id", "data.devhub_role.engineers", "id"),
+					resource.TestCheckResourceAttr("devhub_querydesk_database_permission.role", "permission", "read"),
+					resource.TestCheckNoResourceAttr("devhub_querydesk_database_permission.role", "credential_id"),
+					resource.TestCheckResourceAttrPair("devhub_querydesk_database_permission.user", "credential_id", "devhub_querydesk_database.test", "credential_ids.postgres"),
+					resource.TestCheckResourceAttrPair("devhub_querydesk_database_permission.user", "organization_user_id", "data.devhub_user.michael", "id"),
+					resource.TestCheckResourceAttr("devhub_querydesk_database_permission.user", "permission", "approve"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devhub_querydesk_database_permission.role",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDatabasePermissionImportStateIdFunc("devhub_querydesk_database_permission.role"),
+			},
+			{
+				ResourceName:      "devhub_querydesk_database_permission.user",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDatabasePermissionImportStateIdFunc("devhub_querydesk_database_permission.user"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccDatabasePermissionResourceConfig(name, "write"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_querydesk_database_permission.role", "permission", "write"),
+					resource.TestCheckResourceAttr("devhub_querydesk_database_permission.user", "permission", "approve"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDatabasePermissionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["database_id"] + "/" + rs.Primary.ID, nil
+	}
+}
+
+func testAccDatabasePermissionResourceConfig(name string, permission string) string {
+	return providerConfig + fmt.Sprintf(`
+data "devhub_role" "engineers" {
+  name = "Engineers"
+}
+
+data "devhub_user" "michael" {
+  email = "michael@devhub.tools"
+}
+
+resource "devhub_querydesk_database" "test" {
+  name     = %[1]q
+  adapter  = "POSTGRES"
+  hostname = "localhost"
+  database = "mydb"
+
+	credentials = [
+		{
+			username = "postgres"
+			password = "password"
+			reviews_required = 0
+		}
+	]
+}
+
+resource "devhub_querydesk_database_permission" "role" {
+	database_id = devhub_querydesk_database.test.id
+	role_id     = data.devhub_role.engineers.id
+	permission  = %[2]q
+}
+
+resource "devhub_querydesk_database_permission" "user" {
+	database_id          = devhub_querydesk_database.test.id
+	credential_id        = devhub_querydesk_database.test.credential_ids["postgres"]
+	organization_user_id = data.devhub_user.michael.id
+	permission           = "approve"
+}
+`, name, permission)
+}
@@ -5,18 +5,31 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	devhub "terraform-provider-devhub/internal/client"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -25,6 +38,7 @@ var (
 	_ resource.Resource                = &databaseResource{}
 	_ resource.ResourceWithConfigure   = &databaseResource{}
 	_ resource.ResourceWithImportState = &databaseResource{}
+	_ resource.ResourceWithModifyPlan  = &databaseResource{}
 )
 
 func DatabaseResource() resource.Resource {
@@ -33,31 +47,64 @@ func DatabaseResource() resource.Resource {
 
 // DatabaseResourceModel describes the resource data model.
 type databaseResourceModel struct {
-	Id             types.String              `tfsdk:"id"`
-	Name           types.String              `tfsdk:"name"`
-	Adapter        types.String              `tfsdk:"adapter"`
-	Hostname       types.String              `tfsdk:"hostname"`
-	Port           types.Int64               `tfsdk:"port"`
-	Database       types.String              `tfsdk:"database"`
-	Ssl            types.Bool                `tfsdk:"ssl"`
-	Cacertfile     types.String              `tfsdk:"cacertfile"`
-	Keyfile        types.String              `tfsdk:"keyfile"`
-	Certfile       types.String              `tfsdk:"certfile"`
-	RestrictAccess types.Bool                `tfsdk:"restrict_access"`
-	Group          types.String              `tfsdk:"group"`
-	SlackChannel   types.String              `tfsdk:"slack_channel"`
-	AgentId        types.String              `tfsdk:"agent_id"`
-	Credentials    []databaseCredentialModel `tfsdk:"credentials"`
-	CredentialIds  types.Map                 `tfsdk:"credential_ids"`
+	Id                types.String              `tfsdk:"id"`
+	Name              types.String              `tfsdk:"name"`
+	Adapter           types.String              `tfsdk:"adapter"`
+	Hostname          types.String              `tfsdk:"hostname"`
+	Port              types.Int64               `tfsdk:"port"`
+	Database          types.String              `tfsdk:"database"`
+	Ssl               types.Bool                `tfsdk:"ssl"`
+	Cacertfile        types.String              `tfsdk:"cacertfile"`
+	Keyfile           types.String              `tfsdk:"keyfile"`
+	Certfile          types.String              `tfsdk:"certfile"`
+	CacertFingerprint types.String              `tfsdk:"cacert_fingerprint"`
+	CertFingerprint   types.String              `tfsdk:"cert_fingerprint"`
+	CertNotAfter      types.String              `tfsdk:"cert_not_after"`
+	RestrictAccess    types.Bool                `tfsdk:"restrict_access"`
+	Group             types.String              `tfsdk:"group"`
+	SlackChannel      types.String              `tfsdk:"slack_channel"`
+	AgentId           types.String              `tfsdk:"agent_id"`
+	SshTunnel         *databaseSshTunnelModel   `tfsdk:"ssh_tunnel"`
+	Credentials       []databaseCredentialModel `tfsdk:"credentials"`
+	CredentialIds     types.Map                 `tfsdk:"credential_ids"`
+	VerifyConnection  types.Bool                `tfsdk:"verify_connection"`
+	ConnectionChecks  types.Map                 `tfsdk:"connection_checks"`
+}
+
+type databaseSshTunnelModel struct {
+	Host                 types.String `tfsdk:"host"`
+	Port                 types.Int64  `tfsdk:"port"`
+	User                 types.String `tfsdk:"user"`
+	PrivateKey           types.String `tfsdk:"private_key"`
+	KnownHostFingerprint types.String `tfsdk:"known_host_fingerprint"`
 }
 
 type databaseCredentialModel struct {
-	Id                types.String `tfsdk:"id"`
-	Username          types.String `tfsdk:"username"`
-	Password          types.String `tfsdk:"password"`
-	Hostname          types.String `tfsdk:"hostname"`
-	ReviewsRequired   types.Int64  `tfsdk:"reviews_required"`
-	DefaultCredential types.Bool   `tfsdk:"default_credential"`
+	Id                types.String                         `tfsdk:"id"`
+	Username          types.String                         `tfsdk:"username"`
+	Password          types.String                         `tfsdk:"password"`
+	PasswordWo        types.String                         `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64                          `tfsdk:"password_wo_version"`
+	Hostname          types.String                         `tfsdk:"hostname"`
+	ReviewsRequired   types.Int64                          `tfsdk:"reviews_required"`
+	DefaultCredential types.Bool                           `tfsdk:"default_credential"`
+	ReviewPolicy      *databaseCredentialReviewPolicyModel `tfsdk:"review_policy"`
+}
+
+type databaseCredentialReviewPolicyModel struct {
+	RequiredReviewerRoleIds []types.String `tfsdk:"required_reviewer_role_ids"`
+	AllowSelfApproval       types.Bool     `tfsdk:"allow_self_approval"`
+	AutoApproveReadOnly     types.Bool     `tfsdk:"auto_approve_read_only"`
+	ReviewExpiry            types.String   `tfsdk:"review_expiry"`
+}
+
+var databaseConnectionCheckType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"status":     types.StringType,
+		"error_type": types.StringType,
+		"message":    types.StringType,
+		"checked_at": types.StringType,
+	},
 }
 
 type databaseResource struct {
@@ -111,6 +158,9 @@ func (r *databaseResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				MarkdownDescription: "The server ca cert to use with ssl connections, `ssl` must be set to `true`.",
 				Optional:            true,
 				Sensitive:           true,
+				Validators: []validator.String{
+					pemCertificate(),
+				},
 			},
 			"keyfile": schema.StringAttribute{
 				MarkdownDescription: "The client key to use with ssl connections, `ssl` must be set to `true`.",
@@ -121,6 +171,21 @@ func (r *databaseResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				MarkdownDescription: "The client cert to use with ssl connections, `ssl` must be set to `true`.",
 				Optional:            true,
 				Sensitive:           true,
+				Validators: []validator.String{
+					pemCertificate(),
+				},
+			},
+			"cacert_fingerprint": schema.StringAttribute{
+				MarkdownDescription: "The SHA256 fingerprint of `cacertfile` as lowercase hex. A change made outside of Terraform shows up as a diff on `cacertfile`.",
+				Computed:            true,
+			},
+			"cert_fingerprint": schema.StringAttribute{
+				MarkdownDescription: "The SHA256 fingerprint of `certfile` as lowercase hex. A change made outside of Terraform shows up as a diff on `certfile`.",
+				Computed:            true,
+			},
+			"cert_not_after": schema.StringAttribute{
+				MarkdownDescription: "When the client cert in `certfile` expires, in RFC 3339 format.",
+				Computed:            true,
 			},
 			"restrict_access": schema.BoolAttribute{
 				MarkdownDescription: "Whether access to this databases should be explicitly granted to users or if any authenticated user can access it.",
@@ -135,11 +200,55 @@ func (r *databaseResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			"slack_channel": schema.StringAttribute{
 				MarkdownDescription: "The slack channel to send query request notifications to.",
 				Optional:            true,
+				Validators: []validator.String{
+					slackChannel(),
+				},
 			},
 			"agent_id": schema.StringAttribute{
 				MarkdownDescription: "The agent id for the database.",
 				Optional:            true,
 			},
+			"ssh_tunnel": schema.SingleNestedAttribute{
+				MarkdownDescription: "Connect to the database through an ssh bastion host, for databases that can't be reached directly and where running an agent isn't possible.",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("agent_id")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						MarkdownDescription: "The hostname or ip of the bastion host.",
+						Required:            true,
+					},
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "The ssh port of the bastion host.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(22),
+					},
+					"user": schema.StringAttribute{
+						MarkdownDescription: "The user to authenticate as on the bastion host.",
+						Required:            true,
+					},
+					"private_key": schema.StringAttribute{
+						MarkdownDescription: "The private key used to authenticate with the bastion host, must not be protected by a passphrase.",
+						Required:            true,
+						Sensitive:           true,
+						Validators: []validator.String{
+							sshPrivateKey(),
+						},
+					},
+					"known_host_fingerprint": schema.StringAttribute{
+						MarkdownDescription: "The SHA256 fingerprint of the bastion host key as printed by `ssh-keygen -lf`, for example `SHA256:uNiVztksCsDhcc0u9e8BujQXVUpKZIDTMczCvj3tD2s`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^SHA256:[A-Za-z0-9+/]{43}$`),
+								"must be a SHA256 host key fingerprint, for example `SHA256:uNiVztksCsDhcc0u9e8BujQXVUpKZIDTMczCvj3tD2s`",
+							),
+						},
+					},
+				},
+			},
 			"credential_ids": schema.MapAttribute{
 				ElementType:         types.StringType,
 				Computed:            true,
@@ -148,6 +257,37 @@ func (r *databaseResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					mapplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"verify_connection": schema.BoolAttribute{
+				MarkdownDescription: "Set to `true` to test the connection for each credential after every create and update, failing the apply if any of them can't connect. " +
+					"A failed test on create taints the database so it's recreated on the next apply, a failed test on update is run again on the next apply.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"connection_checks": schema.MapNestedAttribute{
+				MarkdownDescription: "The result of the last connection test for each credential by username, only set when `verify_connection` is `true`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The result of the connection test, either `ok` or `error`.",
+							Computed:            true,
+						},
+						"error_type": schema.StringAttribute{
+							MarkdownDescription: "The type of failure when the connection test failed: `dns`, `auth`, `tls`, `timeout` or `unknown`.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "The error returned when connecting to the database.",
+							Computed:            true,
+						},
+						"checked_at": schema.StringAttribute{
+							MarkdownDescription: "When the connection test ran.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 			"credentials": schema.ListNestedAttribute{
 				Required: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -164,9 +304,29 @@ func (r *databaseResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 							Required:            true,
 						},
 						"password": schema.StringAttribute{
-							MarkdownDescription: "The username to use for connecting to the database.",
-							Required:            true,
+							MarkdownDescription: "The password to use for connecting to the database, either `password` or `password_wo` must be set.",
+							Optional:            true,
 							Sensitive:           true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("password"),
+									path.MatchRelative().AtParent().AtName("password_wo"),
+								),
+							},
+						},
+						"password_wo": schema.StringAttribute{
+							MarkdownDescription: "Write-only alternative to `password` that is never stored in state, requires Terraform 1.11 or later. It is only sent to DevHub when the credential is created, switches from `password` or `password_wo_version` changes. " +
+								"After an import the first apply stores `password_wo_version` in state without sending the password again.",
+							Optional:  true,
+							Sensitive: true,
+							WriteOnly: true,
+						},
+						"password_wo_version": schema.Int64Attribute{
+							MarkdownDescription: "Change this value to send an updated `password_wo` to DevHub.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("password_wo")),
+							},
 						},
 						"hostname": schema.StringAttribute{
 							MarkdownDescription: "The hostname to use for connecting to the database when using this credential (overrides the default hostname).",
@@ -176,6 +336,40 @@ func (r *databaseResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 							MarkdownDescription: "The number of reviews required before a query can be executed.",
 							Required:            true,
 						},
+						"review_policy": schema.SingleNestedAttribute{
+							MarkdownDescription: "Additional rules for reviewing queries run with this credential.",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"required_reviewer_role_ids": schema.SetAttribute{
+									MarkdownDescription: "Only count reviews from users with one of these roles towards `reviews_required`.",
+									ElementType:         types.StringType,
+									Optional:            true,
+									Validators: []validator.Set{
+										setvalidator.SizeAtLeast(1),
+									},
+								},
+								"allow_self_approval": schema.BoolAttribute{
+									MarkdownDescription: "Whether the author of a query can approve it themselves.",
+									Optional:            true,
+									Computed:            true,
+									Default:             booldefault.StaticBool(false),
+								},
+								"auto_approve_read_only": schema.BoolAttribute{
+									MarkdownDescription: "Whether read-only `SELECT` statements are approved automatically without any reviews.",
+									Optional:            true,
+									Computed:            true,
+									Default:             booldefault.StaticBool(false),
+								},
+								"review_expiry": schema.StringAttribute{
+									MarkdownDescription: "How long an approval is valid before the query has to be reviewed again, for example `24h`. Approvals don't expire if not set.",
+									Optional:            true,
+									Validators: []validator.String{
+										positiveDuration(),
+										wholeSecondsDuration(),
+									},
+								},
+							},
+						},
 						"default_credential": schema.BoolAttribute{
 							MarkdownDescription: "Whether this is the default credential for the database.",
 							Optional:            true,
@@ -198,14 +392,23 @@ func (r *databaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	// write-only attributes are only available in the config
+	var config databaseResourceModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var credentials []devhub.DatabaseCredential
-	for _, credential := range plan.Credentials {
+	for index, credential := range plan.Credentials {
 		credentials = append(credentials, devhub.DatabaseCredential{
 			Username:          credential.Username.ValueString(),
-			Password:          credential.Password.ValueString(),
+			Password:          databaseCredentialPassword(credential, config.Credentials[index], nil, false),
 			Hostname:          credential.Hostname.ValueString(),
 			ReviewsRequired:   int(credential.ReviewsRequired.ValueInt64()),
 			DefaultCredential: credential.DefaultCredential.ValueBool(),
+			ReviewPolicy:      databaseCredentialReviewPolicyInput(credential.ReviewPolicy),
 		})
 	}
 
@@ -232,6 +435,16 @@ func (r *databaseResource) Create(ctx context.Context, req resource.CreateReques
 		Credentials:    credentials,
 	}
 
+	if plan.SshTunnel != nil {
+		input.SshTunnel = &devhub.DatabaseSshTunnel{
+			Host:                 plan.SshTunnel.Host.ValueString(),
+			Port:                 plan.SshTunnel.Port.ValueInt64(),
+			User:                 plan.SshTunnel.User.ValueString(),
+			PrivateKey:           plan.SshTunnel.PrivateKey.ValueString(),
+			KnownHostFingerprint: plan.SshTunnel.KnownHostFingerprint.ValueString(),
+		}
+	}
+
 	database, err := r.client.CreateDatabase(input)
 
 	if err != nil {
@@ -255,14 +468,77 @@ func (r *databaseResource) Create(ctx context.Context, req resource.CreateReques
 
 	plan.CredentialIds = types.MapValueMust(types.StringType, credentialIds)
 
+	setCertificateMetadata(&plan)
+
+	connectionDiags := r.verifyConnection(database, &plan)
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(connectionDiags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// ModifyPlan computes the fingerprints and expiry of the configured certs so changing a
+// cert shows the new values in the plan, and plans failed connection tests to run again.
+func (r *databaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when destroying
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan databaseResourceModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cacertfile"), &plan.Cacertfile)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("certfile"), &plan.Certfile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setCertificateMetadata(&plan)
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cacert_fingerprint"), plan.CacertFingerprint)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cert_fingerprint"), plan.CertFingerprint)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cert_not_after"), plan.CertNotAfter)...)
+
+	resp.Diagnostics.Append(planConnectionChecks(ctx, req, resp)...)
+}
+
+// planConnectionChecks plans an update when a connection test failed, so tests that failed
+// an update are run again on the next apply.
+func planConnectionChecks(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if req.State.Raw.IsNull() {
+		return diags
+	}
+
+	var verifyConnection types.Bool
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("verify_connection"), &verifyConnection)...)
+
+	var checks types.Map
+	diags.Append(req.State.GetAttribute(ctx, path.Root("connection_checks"), &checks)...)
+
+	if diags.HasError() || !verifyConnection.ValueBool() {
+		return diags
+	}
+
+	for _, element := range checks.Elements() {
+		check, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+
+		if status, ok := check.Attributes()["status"].(types.String); ok && status.ValueString() != "ok" {
+			diags.Append(resp.Plan.SetAttribute(ctx, path.Root("connection_checks"), types.MapUnknown(databaseConnectionCheckType))...)
+			return diags
+		}
+	}
+
+	return diags
+}
+
 func (r *databaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
 	var state databaseResourceModel
@@ -294,6 +570,32 @@ func (r *databaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 	state.Ssl = types.BoolValue(database.Ssl)
 	state.RestrictAccess = types.BoolValue(database.RestrictAccess)
 
+	// the pem files are never returned by the api, null them when the remote fingerprint
+	// changed so the config shows a diff
+	state.Cacertfile = databasePemFileState(state.Cacertfile, database.CacertfileFingerprint)
+	state.Keyfile = databasePemFileState(state.Keyfile, database.KeyfileFingerprint)
+	state.Certfile = databasePemFileState(state.Certfile, database.CertfileFingerprint)
+
+	// start from the pem files in state as DevHub may not return the metadata
+	setCertificateMetadata(&state)
+
+	if database.CacertfileFingerprint != "" {
+		state.CacertFingerprint = types.StringValue(database.CacertfileFingerprint)
+	}
+
+	if database.CertfileFingerprint != "" {
+		state.CertFingerprint = types.StringValue(database.CertfileFingerprint)
+	}
+
+	if database.CertfileNotAfter != "" {
+		state.CertNotAfter = types.StringValue(database.CertfileNotAfter)
+
+		// use the same format as ModifyPlan so it doesn't show a diff
+		if notAfter, err := time.Parse(time.RFC3339, database.CertfileNotAfter); err == nil {
+			state.CertNotAfter = types.StringValue(notAfter.UTC().Format(time.RFC3339))
+		}
+	}
+
 	state.Port = types.Int64Null()
 	state.Group = types.StringNull()
 	state.SlackChannel = types.StringNull()
@@ -315,6 +617,24 @@ func (r *databaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 		state.AgentId = types.StringValue(database.AgentId)
 	}
 
+	if database.SshTunnel == nil {
+		state.SshTunnel = nil
+	} else {
+		// the private key is never returned by the api so keep the one from state
+		privateKey := types.StringNull()
+		if state.SshTunnel != nil {
+			privateKey = state.SshTunnel.PrivateKey
+		}
+
+		state.SshTunnel = &databaseSshTunnelModel{
+			Host:                 types.StringValue(database.SshTunnel.Host),
+			Port:                 types.Int64Value(database.SshTunnel.Port),
+			User:                 types.StringValue(database.SshTunnel.User),
+			PrivateKey:           privateKey,
+			KnownHostFingerprint: types.StringValue(database.SshTunnel.KnownHostFingerprint),
+		}
+	}
+
 	if state.Credentials == nil || len(state.Credentials) != len(database.Credentials) {
 		state.Credentials = make([]databaseCredentialModel, len(database.Credentials))
 	}
@@ -333,11 +653,18 @@ func (r *databaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 			state.Credentials[index].Hostname = types.StringValue(credential.Hostname)
 		}
 
+		state.Credentials[index].ReviewPolicy = newDatabaseCredentialReviewPolicyModel(credential.ReviewPolicy, state.Credentials[index].ReviewPolicy)
+
 		credentialIds[credential.Username] = types.StringValue(credential.Id)
 	}
 
 	state.CredentialIds = types.MapValueMust(types.StringType, credentialIds)
 
+	// verify_connection is only known to the provider, default it after an import
+	if state.VerifyConnection.IsNull() {
+		state.VerifyConnection = types.BoolValue(false)
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -355,15 +682,36 @@ func (r *databaseResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	var config databaseResourceModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state databaseResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	imported, diags := req.Private.GetKey(ctx, databaseImportedKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var credentials []devhub.DatabaseCredential
-	for _, credential := range plan.Credentials {
+	for index, credential := range plan.Credentials {
 		credentials = append(credentials, devhub.DatabaseCredential{
 			Id:                credential.Id.ValueString(),
 			Username:          credential.Username.ValueString(),
-			Password:          credential.Password.ValueString(),
+			Password:          databaseCredentialPassword(credential, config.Credentials[index], state.Credentials, string(imported) == "true"),
 			Hostname:          credential.Hostname.ValueString(),
 			ReviewsRequired:   int(credential.ReviewsRequired.ValueInt64()),
 			DefaultCredential: credential.DefaultCredential.ValueBool(),
+			ReviewPolicy:      databaseCredentialReviewPolicyInput(credential.ReviewPolicy),
 		})
 	}
 
@@ -390,6 +738,16 @@ func (r *databaseResource) Update(ctx context.Context, req resource.UpdateReques
 		Credentials:    credentials,
 	}
 
+	if plan.SshTunnel != nil {
+		input.SshTunnel = &devhub.DatabaseSshTunnel{
+			Host:                 plan.SshTunnel.Host.ValueString(),
+			Port:                 plan.SshTunnel.Port.ValueInt64(),
+			User:                 plan.SshTunnel.User.ValueString(),
+			PrivateKey:           plan.SshTunnel.PrivateKey.ValueString(),
+			KnownHostFingerprint: plan.SshTunnel.KnownHostFingerprint.ValueString(),
+		}
+	}
+
 	// Update existing order
 	database, err := r.client.UpdateDatabase(plan.Id.ValueString(), input)
 	if err != nil {
@@ -410,8 +768,16 @@ func (r *databaseResource) Update(ctx context.Context, req resource.UpdateReques
 
 	plan.CredentialIds = types.MapValueMust(types.StringType, credentialIds)
 
+	setCertificateMetadata(&plan)
+
+	connectionDiags := r.verifyConnection(database, &plan)
+
+	// the password_wo_version of imported credentials is stored now
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, databaseImportedKey, nil)...)
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(connectionDiags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -436,6 +802,241 @@ func (r *databaseResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 }
 
+// databaseCredentialPassword returns the password to send for a credential. A password_wo
+// is sent for new credentials, credentials that used `password` before and when
+// password_wo_version changed, otherwise an empty password is sent which keeps the current
+// password in DevHub. After an import the first version doesn't resend the password.
+func databaseCredentialPassword(credential databaseCredentialModel, configCredential databaseCredentialModel, previousCredentials []databaseCredentialModel, imported bool) string {
+	if configCredential.PasswordWo.IsNull() {
+		return credential.Password.ValueString()
+	}
+
+	for _, previous := range previousCredentials {
+		if previous.Id.ValueString() == credential.Id.ValueString() {
+			if imported && previous.PasswordWoVersion.IsNull() {
+				return ""
+			}
+
+			if previous.Password.IsNull() && previous.PasswordWoVersion.Equal(credential.PasswordWoVersion) {
+				return ""
+			}
+
+			break
+		}
+	}
+
+	return configCredential.PasswordWo.ValueString()
+}
+
+// databaseImportedKey is the private state key marking a database as imported until the
+// first update, as imported credentials have no password_wo_version yet.
+const databaseImportedKey = "imported"
+
+func databaseCredentialReviewPolicyInput(reviewPolicy *databaseCredentialReviewPolicyModel) *devhub.DatabaseCredentialReviewPolicy {
+	if reviewPolicy == nil {
+		return nil
+	}
+
+	input := &devhub.DatabaseCredentialReviewPolicy{
+		RequiredReviewerRoleIds: make([]string, 0, len(reviewPolicy.RequiredReviewerRoleIds)),
+		AllowSelfApproval:       reviewPolicy.AllowSelfApproval.ValueBool(),
+		AutoApproveReadOnly:     reviewPolicy.AutoApproveReadOnly.ValueBool(),
+	}
+
+	for _, roleId := range reviewPolicy.RequiredReviewerRoleIds {
+		input.RequiredReviewerRoleIds = append(input.RequiredReviewerRoleIds, roleId.ValueString())
+	}
+
+	if !reviewPolicy.ReviewExpiry.IsNull() {
+		// already checked by the positiveDuration validator
+		expiry, _ := time.ParseDuration(reviewPolicy.ReviewExpiry.ValueString())
+		seconds := int64(expiry.Seconds())
+		input.ReviewExpirySeconds = &seconds
+	}
+
+	return input
+}
+
+// newDatabaseCredentialReviewPolicyModel converts the api review policy, keeping the
+// review_expiry from state when it's the same duration written differently, e.g. `1440m`.
+func newDatabaseCredentialReviewPolicyModel(reviewPolicy *devhub.DatabaseCredentialReviewPolicy, previous *databaseCredentialReviewPolicyModel) *databaseCredentialReviewPolicyModel {
+	if reviewPolicy == nil {
+		return nil
+	}
+
+	model := &databaseCredentialReviewPolicyModel{
+		AllowSelfApproval:   types.BoolValue(reviewPolicy.AllowSelfApproval),
+		AutoApproveReadOnly: types.BoolValue(reviewPolicy.AutoApproveReadOnly),
+		ReviewExpiry:        types.StringNull(),
+	}
+
+	for _, roleId := range reviewPolicy.RequiredReviewerRoleIds {
+		model.RequiredReviewerRoleIds = append(model.RequiredReviewerRoleIds, types.StringValue(roleId))
+	}
+
+	if reviewPolicy.ReviewExpirySeconds != nil {
+		expiry := time.Duration(*reviewPolicy.ReviewExpirySeconds) * time.Second
+		model.ReviewExpiry = types.StringValue(formatDuration(expiry))
+
+		if previous != nil {
+			previousExpiry, err := time.ParseDuration(previous.ReviewExpiry.ValueString())
+			if err == nil && previousExpiry == expiry {
+				model.ReviewExpiry = previous.ReviewExpiry
+			}
+		}
+	}
+
+	return model
+}
+
+// setCertificateMetadata sets the cert fingerprints and expiry from the pem files of the model.
+func setCertificateMetadata(model *databaseResourceModel) {
+	model.CacertFingerprint = pemFingerprintValue(model.Cacertfile)
+	model.CertFingerprint = pemFingerprintValue(model.Certfile)
+	model.CertNotAfter = types.StringNull()
+
+	if model.Certfile.IsUnknown() {
+		model.CertNotAfter = types.StringUnknown()
+		return
+	}
+
+	if model.Certfile.IsNull() {
+		return
+	}
+
+	cert, err := parsePemCertificate(model.Certfile.ValueString())
+	if err == nil {
+		model.CertNotAfter = types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339))
+	}
+}
+
+func pemFingerprintValue(value types.String) types.String {
+	if value.IsUnknown() {
+		return types.StringUnknown()
+	}
+
+	if value.IsNull() {
+		return types.StringNull()
+	}
+
+	fingerprint, err := pemFingerprint(value.ValueString())
+	if err != nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(fingerprint)
+}
+
+// databasePemFileState returns the pem file to keep in state, or null if it doesn't
+// match the fingerprint DevHub has for it. It's kept when DevHub returned no fingerprint.
+func databasePemFileState(value types.String, remoteFingerprint string) types.String {
+	if value.IsNull() || remoteFingerprint == "" {
+		return value
+	}
+
+	fingerprint, err := pemFingerprint(value.ValueString())
+	if err != nil || fingerprint != remoteFingerprint {
+		return types.StringNull()
+	}
+
+	return value
+}
+
+// pemFingerprint returns the SHA256 fingerprint of the first pem block as lowercase hex.
+func pemFingerprint(data string) (string, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return "", errors.New("no pem data found")
+	}
+
+	sum := sha256.Sum256(block.Bytes)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// formatDuration formats a duration without trailing zero units, `24h` instead of `24h0m0s`.
+func formatDuration(duration time.Duration) string {
+	formatted := duration.String()
+
+	if strings.HasSuffix(formatted, "m0s") {
+		formatted = strings.TrimSuffix(formatted, "0s")
+	}
+
+	if strings.HasSuffix(formatted, "h0m") {
+		formatted = strings.TrimSuffix(formatted, "0m")
+	}
+
+	return formatted
+}
+
+// verifyConnection tests the connection of each credential when `verify_connection` is
+// set and stores the results on the plan. The returned diagnostics should be added after
+// the state is saved so a failed check doesn't lose track of the database.
+func (r *databaseResource) verifyConnection(database *devhub.Database, plan *databaseResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !plan.VerifyConnection.ValueBool() {
+		plan.ConnectionChecks = types.MapNull(databaseConnectionCheckType)
+		return diags
+	}
+
+	checks := make(map[string]attr.Value)
+
+	for index, credential := range database.Credentials {
+		credentialPath := path.Root("credentials").AtListIndex(index)
+
+		check, err := r.client.TestDatabaseConnection(database.Id, credential.Id)
+		if err != nil {
+			diags.AddAttributeError(
+				credentialPath,
+				"Error testing database connection",
+				"Could not test connection for credential "+credential.Username+", unexpected error: "+err.Error(),
+			)
+			continue
+		}
+
+		checks[credential.Username] = types.ObjectValueMust(databaseConnectionCheckType.AttrTypes, map[string]attr.Value{
+			"status":     types.StringValue(check.Status),
+			"error_type": types.StringValue(check.ErrorType),
+			"message":    types.StringValue(check.Message),
+			"checked_at": types.StringValue(check.CheckedAt),
+		})
+
+		if check.Status == "ok" {
+			continue
+		}
+
+		hostname := database.Hostname
+		if credential.Hostname != "" {
+			hostname = credential.Hostname
+		}
+
+		var summary string
+		switch check.ErrorType {
+		case "dns":
+			summary = "Could not resolve database hostname " + hostname
+		case "auth":
+			summary = "Database authentication failed for user " + credential.Username
+		case "tls":
+			summary = "TLS error connecting to database " + hostname
+		case "timeout":
+			summary = "Timed out connecting to database " + hostname
+		default:
+			summary = "Could not connect to database " + hostname
+		}
+
+		diags.AddAttributeError(
+			credentialPath,
+			summary,
+			"The connection test for credential "+credential.Username+" failed: "+check.Message,
+		)
+	}
+
+	plan.ConnectionChecks = types.MapValueMust(databaseConnectionCheckType, checks)
+
+	return diags
+}
+
 func (r *databaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -456,6 +1057,24 @@ func (r *databaseResource) Configure(_ context.Context, req resource.ConfigureRe
 	r.client = client
 }
 
+// ImportState accepts either a database id or `name:<database name>`.
 func (r *databaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, databaseImportedKey, []byte("true"))...)
+
+	name, found := strings.CutPrefix(req.ID, "name:")
+	if !found {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	database, err := r.client.GetDatabaseByName(name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing database",
+			"Could not find database named "+name+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), database.Id)...)
 }
@@ -1,11 +1,26 @@
 package provider
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
 	"fmt"
+	"math/big"
+	"regexp"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"golang.org/x/crypto/ssh"
 )
 
 func TestAccDatabaseResource(t *testing.T) {
@@ -22,6 +37,8 @@ func TestAccDatabaseResource(t *testing.T) {
 					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "hostname", "localhost"),
 					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "ssl", "false"),
 					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "restrict_access", "true"),
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "verify_connection", "false"),
+					resource.TestCheckNoResourceAttr("devhub_querydesk_database.test", "connection_checks.%"),
 					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "credentials.0.username", "postgres"),
 					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "credentials.0.password", "password"),
 					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "credentials.0.reviews_required", "0"),
@@ -41,6 +58,13 @@ func TestAccDatabaseResource(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"credentials.0.password", "credentials.1.password"},
 			},
+			{
+				ResourceName:            "devhub_querydesk_database.test",
+				ImportState:             true,
+				ImportStateId:           "name:" + name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials.0.password", "credentials.1.password"},
+			},
 			// Update and Read testing
 			{
 				Config: testAccDatabaseResourceConfig(name + "_updated"),
@@ -91,3 +115,469 @@ resource "devhub_querydesk_database" "test" {
 }
 `, name)
 }
+
+func TestAccDatabaseWithVerifyConnectionResource(t *testing.T) {
+	name := fmt.Sprintf("database_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A failed connection test fails the create
+			{
+				Config:      testAccDatabaseWithVerifyConnectionResourceConfig(name, "wrong-password"),
+				ExpectError: regexp.MustCompile("Database authentication failed for user postgres"),
+			},
+			// Create and Read testing, replacing the tainted database
+			{
+				Config: testAccDatabaseWithVerifyConnectionResourceConfig(name, "password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "verify_connection", "true"),
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "connection_checks.%", "1"),
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "connection_checks.postgres.status", "ok"),
+					resource.TestCheckResourceAttrSet("devhub_querydesk_database.test", "connection_checks.postgres.checked_at"),
+				),
+			},
+			// A failed connection test fails the update
+			{
+				Config:      testAccDatabaseWithVerifyConnectionResourceConfig(name, "wrong-password"),
+				ExpectError: regexp.MustCompile("Database authentication failed for user postgres"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDatabaseWithVerifyConnectionResourceConfig(name string, password string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_querydesk_database" "test" {
+  name     = %[1]q
+  adapter  = "POSTGRES"
+  hostname = "localhost"
+  database = "mydb"
+
+	verify_connection = true
+
+	credentials = [
+		{
+			username = "postgres"
+			password = %[2]q
+			reviews_required = 0
+			default_credential = true
+		}
+	]
+}
+`, name, password)
+}
+
+func TestAccDatabaseWithWriteOnlyPasswordResource(t *testing.T) {
+	name := fmt.Sprintf("database_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDatabaseWithWriteOnlyPasswordResourceConfig(name, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("devhub_querydesk_database.test", "credentials.0.password"),
+					resource.TestCheckNoResourceAttr("devhub_querydesk_database.test", "credentials.0.password_wo"),
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "credentials.0.password_wo_version", "1"),
+					resource.TestCheckResourceAttrSet("devhub_querydesk_database.test", "credential_ids.postgres"),
+				),
+			},
+			// ImportState testing, password_wo_version is only known to Terraform and set by the next apply
+			{
+				ResourceName:            "devhub_querydesk_database.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials.0.password_wo_version"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccDatabaseWithWriteOnlyPasswordResourceConfig(name, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("devhub_querydesk_database.test", "credentials.0.password"),
+					resource.TestCheckNoResourceAttr("devhub_querydesk_database.test", "credentials.0.password_wo"),
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "credentials.0.password_wo_version", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDatabaseWithWriteOnlyPasswordResourceConfig(name string, passwordVersion int) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_querydesk_database" "test" {
+  name     = %[1]q
+  adapter  = "POSTGRES"
+  hostname = "localhost"
+  database = "mydb"
+
+	credentials = [
+		{
+			username = "postgres"
+			password_wo = "password"
+			password_wo_version = %[2]d
+			reviews_required = 0
+			default_credential = true
+		}
+	]
+}
+`, name, passwordVersion)
+}
+
+func TestAccDatabaseWithReviewPolicyResource(t *testing.T) {
+	name := fmt.Sprintf("database_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Fractional seconds can't be stored by DevHub
+			{
+				Config:      testAccDatabaseWithReviewPolicyResourceConfig(name, "1.5s"),
+				ExpectError: regexp.MustCompile("Invalid Duration"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccDatabaseWithReviewPolicyResourceConfig(name, "24h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "credentials.0.review_policy.allow_self_approval", "false"),
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "credentials.0.review_policy.auto_approve_read_only", "true"),
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "credentials.0.review_policy.review_expiry", "24h"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "devhub_querydesk_database.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials.0.password"},
+			},
+			// The same duration written differently keeps the configured value without a diff
+			{
+				Config: testAccDatabaseWithReviewPolicyResourceConfig(name, "1440m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "credentials.0.review_policy.review_expiry", "1440m"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccDatabaseWithReviewPolicyResourceConfig(name, "90s"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "credentials.0.review_policy.review_expiry", "90s"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDatabaseWithReviewPolicyResourceConfig(name string, reviewExpiry string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_querydesk_database" "test" {
+  name     = %[1]q
+  adapter  = "POSTGRES"
+  hostname = "localhost"
+  database = "mydb"
+
+	credentials = [
+		{
+			username = "postgres"
+			password = "password"
+			reviews_required = 1
+			default_credential = true
+
+			review_policy = {
+				auto_approve_read_only = true
+				review_expiry          = %[2]q
+			}
+		}
+	]
+}
+`, name, reviewExpiry)
+}
+
+func TestDatabaseCredentialPassword(t *testing.T) {
+	writeOnly := func(version types.Int64) databaseCredentialModel {
+		return databaseCredentialModel{
+			Id:                types.StringValue("cred_1"),
+			Password:          types.StringNull(),
+			PasswordWo:        types.StringValue("write-only"),
+			PasswordWoVersion: version,
+		}
+	}
+
+	withPassword := databaseCredentialModel{
+		Id:                types.StringValue("cred_1"),
+		Password:          types.StringValue("password"),
+		PasswordWo:        types.StringNull(),
+		PasswordWoVersion: types.Int64Null(),
+	}
+
+	testCases := map[string]struct {
+		credential       databaseCredentialModel
+		previous         []databaseCredentialModel
+		imported         bool
+		expectedPassword string
+	}{
+		"password": {
+			credential:       withPassword,
+			previous:         []databaseCredentialModel{withPassword},
+			expectedPassword: "password",
+		},
+		"new credential": {
+			credential:       writeOnly(types.Int64Null()),
+			expectedPassword: "write-only",
+		},
+		"unchanged version": {
+			credential:       writeOnly(types.Int64Value(1)),
+			previous:         []databaseCredentialModel{writeOnly(types.Int64Value(1))},
+			expectedPassword: "",
+		},
+		"unchanged without version": {
+			credential:       writeOnly(types.Int64Null()),
+			previous:         []databaseCredentialModel{writeOnly(types.Int64Null())},
+			expectedPassword: "",
+		},
+		"changed version": {
+			credential:       writeOnly(types.Int64Value(2)),
+			previous:         []databaseCredentialModel{writeOnly(types.Int64Value(1))},
+			expectedPassword: "write-only",
+		},
+		"first version": {
+			credential:       writeOnly(types.Int64Value(1)),
+			previous:         []databaseCredentialModel{writeOnly(types.Int64Null())},
+			expectedPassword: "write-only",
+		},
+		"moved off password": {
+			credential:       writeOnly(types.Int64Null()),
+			previous:         []databaseCredentialModel{withPassword},
+			expectedPassword: "write-only",
+		},
+		"first version after import": {
+			credential:       writeOnly(types.Int64Value(1)),
+			previous:         []databaseCredentialModel{writeOnly(types.Int64Null())},
+			imported:         true,
+			expectedPassword: "",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := testCase.credential
+			testCase.credential.PasswordWo = types.StringNull()
+
+			password := databaseCredentialPassword(testCase.credential, config, testCase.previous, testCase.imported)
+			if password != testCase.expectedPassword {
+				t.Errorf("expected password %q, got: %q", testCase.expectedPassword, password)
+			}
+		})
+	}
+}
+
+func TestAccDatabaseWithSshTunnelResource(t *testing.T) {
+	name := fmt.Sprintf("database_%s", acctest.RandString(10))
+	privateKey, fingerprint := testAccSshKeyPair(t)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDatabaseWithSshTunnelResourceConfig(name, "bastion.example.com", privateKey, fingerprint),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "ssh_tunnel.host", "bastion.example.com"),
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "ssh_tunnel.port", "22"),
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "ssh_tunnel.user", "devhub"),
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "ssh_tunnel.known_host_fingerprint", fingerprint),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "devhub_querydesk_database.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials.0.password", "ssh_tunnel.private_key"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccDatabaseWithSshTunnelResourceConfig(name, "bastion2.example.com", privateKey, fingerprint),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "ssh_tunnel.host", "bastion2.example.com"),
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "ssh_tunnel.port", "22"),
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "ssh_tunnel.user", "devhub"),
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "ssh_tunnel.known_host_fingerprint", fingerprint),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDatabaseWithSshTunnelResourceConfig(name string, host string, privateKey string, fingerprint string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_querydesk_database" "test" {
+  name     = %[1]q
+  adapter  = "POSTGRES"
+  hostname = "10.0.12.4"
+  database = "mydb"
+
+	ssh_tunnel = {
+		host                   = %[2]q
+		user                   = "devhub"
+		private_key            = %[3]q
+		known_host_fingerprint = %[4]q
+	}
+
+	credentials = [
+		{
+			username = "postgres"
+			password = "password"
+			reviews_required = 0
+		}
+	]
+}
+`, name, host, privateKey, fingerprint)
+}
+
+func TestAccDatabaseWithSslCertificatesResource(t *testing.T) {
+	name := fmt.Sprintf("database_%s", acctest.RandString(10))
+	notAfter := time.Now().Add(90 * 24 * time.Hour).UTC().Truncate(time.Second)
+	cert, certFingerprint := testAccCertificate(t, notAfter)
+	renewedCert, renewedCertFingerprint := testAccCertificate(t, notAfter.Add(90*24*time.Hour))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDatabaseWithSslCertificatesResourceConfig(name, cert),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "cacert_fingerprint", certFingerprint),
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "cert_fingerprint", certFingerprint),
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "cert_not_after", notAfter.Format(time.RFC3339)),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccDatabaseWithSslCertificatesResourceConfig(name, renewedCert),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("devhub_querydesk_database.test", tfjsonpath.New("cert_fingerprint"), knownvalue.StringExact(renewedCertFingerprint)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "cacert_fingerprint", renewedCertFingerprint),
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "cert_fingerprint", renewedCertFingerprint),
+					resource.TestCheckResourceAttr("devhub_querydesk_database.test", "cert_not_after", notAfter.Add(90*24*time.Hour).Format(time.RFC3339)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDatabaseWithSslCertificatesResourceConfig(name string, cert string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_querydesk_database" "test" {
+  name     = %[1]q
+  adapter  = "POSTGRES"
+  hostname = "localhost"
+  database = "mydb"
+  ssl      = true
+
+	cacertfile = %[2]q
+	certfile   = %[2]q
+
+	credentials = [
+		{
+			username = "postgres"
+			password = "password"
+			reviews_required = 0
+		}
+	]
+}
+`, name, cert)
+}
+
+func TestDatabasePemFileState(t *testing.T) {
+	cert, fingerprint := testAccCertificate(t, time.Now().Add(24*time.Hour))
+
+	testCases := map[string]struct {
+		value             types.String
+		remoteFingerprint string
+		expectedValue     types.String
+	}{
+		"null": {
+			value:             types.StringNull(),
+			remoteFingerprint: fingerprint,
+			expectedValue:     types.StringNull(),
+		},
+		"matching fingerprint": {
+			value:             types.StringValue(cert),
+			remoteFingerprint: fingerprint,
+			expectedValue:     types.StringValue(cert),
+		},
+		"changed fingerprint": {
+			value:             types.StringValue(cert),
+			remoteFingerprint: "changed",
+			expectedValue:     types.StringNull(),
+		},
+		"no remote fingerprint": {
+			value:             types.StringValue(cert),
+			remoteFingerprint: "",
+			expectedValue:     types.StringValue(cert),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			value := databasePemFileState(testCase.value, testCase.remoteFingerprint)
+			if !value.Equal(testCase.expectedValue) {
+				t.Errorf("expected %s, got: %s", testCase.expectedValue, value)
+			}
+		})
+	}
+}
+
+// testAccCertificate generates a throwaway self-signed certificate and returns it along
+// with its fingerprint.
+func testAccCertificate(t *testing.T, notAfter time.Time) (string, string) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "devhub"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, privateKey.Public(), privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sum := sha256.Sum256(der)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), hex.EncodeToString(sum[:])
+}
+
+// testAccSshKeyPair generates a throwaway private key and returns it along with
+// the fingerprint of its public key.
+func testAccSshKeyPair(t *testing.T) (string, string) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block, err := ssh.MarshalPrivateKey(privateKey, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(block)), ssh.FingerprintSHA256(sshPublicKey)
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	devhub "terraform-provider-devhub/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &databasesDataSource{}
+	_ datasource.DataSourceWithConfigure = &databasesDataSource{}
+)
+
+func NewDatabasesDataSource() datasource.DataSource {
+	return &databasesDataSource{}
+}
+
+type databasesDataSource struct {
+	client *devhub.Client
+}
+
+type databasesDataSourceModel struct {
+	Group     types.String              `tfsdk:"group"`
+	Adapter   types.String              `tfsdk:"adapter"`
+	AgentId   types.String              `tfsdk:"agent_id"`
+	Databases []databaseDataSourceModel `tfsdk:"databases"`
+}
+
+func (d *databasesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_querydesk_databases"
+}
+
+func (d *databasesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists QueryDesk databases, optionally filtered by group, adapter or agent.",
+
+		Attributes: map[string]schema.Attribute{
+			"group": schema.StringAttribute{
+				MarkdownDescription: "Only return databases in this group.",
+				Optional:            true,
+			},
+			"adapter": schema.StringAttribute{
+				MarkdownDescription: "Only return databases using this adapter, for example `POSTGRES`.",
+				Optional:            true,
+			},
+			"agent_id": schema.StringAttribute{
+				MarkdownDescription: "Only return databases connected through this agent.",
+				Optional:            true,
+			},
+			"databases": schema.ListNestedAttribute{
+				MarkdownDescription: "The databases matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: databaseDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *databasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state databasesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databases, err := d.client.ListDatabases(devhub.DatabaseFilter{
+		Group:   state.Group.ValueString(),
+		Adapter: strings.ToLower(state.Adapter.ValueString()),
+		AgentId: state.AgentId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Databases",
+			fmt.Sprintf("Could not list databases: %s", err.Error()),
+		)
+		return
+	}
+
+	state.Databases = make([]databaseDataSourceModel, 0, len(databases))
+
+	for _, database := range databases {
+		state.Databases = append(state.Databases, newDatabaseDataSourceModel(&database))
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *databasesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*devhub.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *devhub.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
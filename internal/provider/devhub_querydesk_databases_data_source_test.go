@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatabasesDataSource(t *testing.T) {
+	group := fmt.Sprintf("group_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by group
+			{
+				Config: testAccDatabasesDataSourceConfig(group, fmt.Sprintf(`
+	group = %q
+`, group)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devhub_querydesk_databases.test", "databases.#", "2"),
+					resource.TestCheckResourceAttr("data.devhub_querydesk_databases.test", "databases.0.group", group),
+					resource.TestCheckResourceAttrSet("data.devhub_querydesk_databases.test", "databases.0.id"),
+				),
+			},
+			// Filter by group and adapter
+			{
+				Config: testAccDatabasesDataSourceConfig(group, fmt.Sprintf(`
+	group   = %q
+	adapter = "MYSQL"
+`, group)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devhub_querydesk_databases.test", "databases.#", "1"),
+					resource.TestCheckResourceAttrPair("data.devhub_querydesk_databases.test", "databases.0.id", "devhub_querydesk_database.mysql", "id"),
+					resource.TestCheckResourceAttr("data.devhub_querydesk_databases.test", "databases.0.adapter", "MYSQL"),
+				),
+			},
+			// Filters without matches return an empty list
+			{
+				Config: testAccDatabasesDataSourceConfig(group, fmt.Sprintf(`
+	group    = %q
+	agent_id = "missing"
+`, group)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devhub_querydesk_databases.test", "databases.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabasesDataSourceConfig(group string, filters string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_querydesk_database" "postgres" {
+  name     = "%[1]s_postgres"
+  adapter  = "POSTGRES"
+  hostname = "localhost"
+  database = "mydb"
+  group    = %[1]q
+
+	credentials = [
+		{
+			username = "postgres"
+			password = "password"
+			reviews_required = 0
+			default_credential = true
+		}
+	]
+}
+
+resource "devhub_querydesk_database" "mysql" {
+  name     = "%[1]s_mysql"
+  adapter  = "MYSQL"
+  hostname = "localhost"
+  database = "mydb"
+  group    = %[1]q
+
+	credentials = [
+		{
+			username = "root"
+			password = "password"
+			reviews_required = 0
+			default_credential = true
+		}
+	]
+}
+
+data "devhub_querydesk_databases" "test" {%[2]s
+	depends_on = [devhub_querydesk_database.postgres, devhub_querydesk_database.mysql]
+}
+`, group, filters)
+}
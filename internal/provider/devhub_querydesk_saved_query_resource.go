@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	devhub "terraform-provider-devhub/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &savedQueryResource{}
+	_ resource.ResourceWithConfigure   = &savedQueryResource{}
+	_ resource.ResourceWithImportState = &savedQueryResource{}
+	_ resource.ResourceWithModifyPlan  = &savedQueryResource{}
+)
+
+func SavedQueryResource() resource.Resource {
+	return &savedQueryResource{}
+}
+
+// savedQueryResourceModel describes the resource data model.
+type savedQueryResourceModel struct {
+	Id            types.String   `tfsdk:"id"`
+	Title         types.String   `tfsdk:"title"`
+	Query         types.String   `tfsdk:"query"`
+	CredentialId  types.String   `tfsdk:"credential_id"`
+	SharedRoleIds []types.String `tfsdk:"shared_role_ids"`
+	Tags          []types.String `tfsdk:"tags"`
+}
+
+type savedQueryResource struct {
+	client *devhub.Client
+}
+
+func (r *savedQueryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_querydesk_saved_query"
+}
+
+func (r *savedQueryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A saved QueryDesk query that can be run against a database credential and shared with roles.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Saved query id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the saved query.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "The SQL of the saved query.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"credential_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the database credential the query is run with. The credential is checked to exist when planning if the id is known.",
+				Required:            true,
+			},
+			"shared_role_ids": schema.SetAttribute{
+				MarkdownDescription: "The ids of the roles the query is shared with. If not set the query is only visible to its owner.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags used to organize the saved query.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan checks that the credential exists so typos in ids fail at plan time instead of on apply.
+func (r *savedQueryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var credentialId types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("credential_id"), &credentialId)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if credentialId.IsNull() || credentialId.IsUnknown() {
+		return
+	}
+
+	_, err := r.client.GetDatabaseCredential(credentialId.ValueString())
+
+	if err != nil && err.Error() == "not found" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("credential_id"),
+			"Credential not found",
+			fmt.Sprintf("No database credential exists with the id %q.", credentialId.ValueString()),
+		)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading credential",
+			"Could not read credential "+credentialId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *savedQueryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan savedQueryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	savedQuery, err := r.client.CreateSavedQuery(savedQueryInput(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating saved query",
+			"Could not create saved query, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(savedQuery.Id)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *savedQueryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state savedQueryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	savedQuery, err := r.client.GetSavedQuery(state.Id.ValueString())
+
+	if err != nil && err.Error() == "not found" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading saved query",
+			"Could not read saved query "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Id = types.StringValue(savedQuery.Id)
+	state.Title = types.StringValue(savedQuery.Title)
+	state.Query = types.StringValue(savedQuery.Query)
+	state.CredentialId = types.StringValue(savedQuery.CredentialId)
+	state.SharedRoleIds = nil
+	state.Tags = nil
+
+	for _, roleId := range savedQuery.SharedRoleIds {
+		state.SharedRoleIds = append(state.SharedRoleIds, types.StringValue(roleId))
+	}
+
+	for _, tag := range savedQuery.Tags {
+		state.Tags = append(state.Tags, types.StringValue(tag))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *savedQueryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan savedQueryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateSavedQuery(plan.Id.ValueString(), savedQueryInput(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating saved query",
+			"Could not update saved query, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *savedQueryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state savedQueryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSavedQuery(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting saved query",
+			"Could not delete saved query, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *savedQueryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*devhub.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *devhub.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *savedQueryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func savedQueryInput(plan savedQueryResourceModel) devhub.SavedQuery {
+	input := devhub.SavedQuery{
+		Id:            plan.Id.ValueString(),
+		Title:         plan.Title.ValueString(),
+		Query:         plan.Query.ValueString(),
+		CredentialId:  plan.CredentialId.ValueString(),
+		SharedRoleIds: make([]string, 0, len(plan.SharedRoleIds)),
+		Tags:          make([]string, 0, len(plan.Tags)),
+	}
+
+	for _, roleId := range plan.SharedRoleIds {
+		input.SharedRoleIds = append(input.SharedRoleIds, roleId.ValueString())
+	}
+
+	for _, tag := range plan.Tags {
+		input.Tags = append(input.Tags, tag.ValueString())
+	}
+
+	return input
+}
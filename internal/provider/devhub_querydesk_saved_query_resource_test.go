@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSavedQueryResource(t *testing.T) {
+	name := fmt.Sprintf("database_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unknown credentials fail when planning
+			{
+				Config: providerConfig + `
+resource "devhub_querydesk_saved_query" "test" {
+	title         = "Recent signups"
+	query         = "SELECT * FROM users"
+	credential_id = "crd_doesnotexist"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Credential not found"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccSavedQueryResourceConfig(name, "SELECT * FROM users"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("devhub_querydesk_saved_query.test", "id"),
+					resource.TestCheckResourceAttr("devhub_querydesk_saved_query.test", "title", "Recent signups"),
+					resource.TestCheckResourceAttr("devhub_querydesk_saved_query.test", "query", "SELECT * FROM users"),
+					resource.TestCheckResourceAttrPair("devhub_querydesk_saved_query.test", "credential_id", "devhub_querydesk_database.test", "credential_ids.postgres"),
+					resource.TestCheckTypeSetElemAttrPair("devhub_querydesk_saved_query.test", "shared_role_ids.*", "data.devhub_role.engineers", "id"),
+					resource.TestCheckResourceAttr("devhub_querydesk_saved_query.test", "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr("devhub_querydesk_saved_query.test", "tags.*", "users"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devhub_querydesk_saved_query.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSavedQueryResourceConfig(name, "SELECT * FROM users ORDER BY inserted_at DESC"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_querydesk_saved_query.test", "query", "SELECT * FROM users ORDER BY inserted_at DESC"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSavedQueryResourceConfig(name string, query string) string {
+	return providerConfig + fmt.Sprintf(`
+data "devhub_role" "engineers" {
+  name = "Engineers"
+}
+
+resource "devhub_querydesk_database" "test" {
+  name     = %[1]q
+  adapter  = "POSTGRES"
+  hostname = "localhost"
+  database = "mydb"
+
+	credentials = [
+		{
+			username = "postgres"
+			password = "password"
+			reviews_required = 0
+		}
+	]
+}
+
+resource "devhub_querydesk_saved_query" "test" {
+	title           = "Recent signups"
+	query           = %[2]q
+	credential_id   = devhub_querydesk_database.test.credential_ids["postgres"]
+	shared_role_ids = [data.devhub_role.engineers.id]
+	tags            = ["users", "support"]
+}
+`, name, query)
+}
@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	devhub "terraform-provider-devhub/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &terradeskPolicySetResource{}
+	_ resource.ResourceWithConfigure   = &terradeskPolicySetResource{}
+	_ resource.ResourceWithImportState = &terradeskPolicySetResource{}
+)
+
+func TerradeskPolicySetResource() resource.Resource {
+	return &terradeskPolicySetResource{}
+}
+
+// terradeskPolicySetResourceModel describes the resource data model.
+type terradeskPolicySetResourceModel struct {
+	Id               types.String            `tfsdk:"id"`
+	Name             types.String            `tfsdk:"name"`
+	Policies         map[string]types.String `tfsdk:"policies"`
+	Repository       types.String            `tfsdk:"repository"`
+	Path             types.String            `tfsdk:"path"`
+	EnforcementLevel types.String            `tfsdk:"enforcement_level"`
+	WorkspaceIds     []types.String          `tfsdk:"workspace_ids"`
+}
+
+type terradeskPolicySetResource struct {
+	client *devhub.Client
+}
+
+func (r *terradeskPolicySetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terradesk_policy_set"
+}
+
+func (r *terradeskPolicySetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A set of OPA Rego policies that plans of the attached TerraDesk workspaces are checked against before they can be approved. " +
+			"Exactly one of `policies` or `path` must be set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Policy set id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the policy set.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"policies": schema.MapAttribute{
+				MarkdownDescription: "Rego policies by file name, for example `main.rego`. The package declaration, strings and brackets of each policy are checked when planning.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[^/]+\.rego$`), "must be a file name ending in .rego")),
+					mapvalidator.ValueStringsAre(regoPolicy()),
+					mapvalidator.ExactlyOneOf(path.MatchRoot("path")),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "The GitHub repository containing `path` in the format `owner/name`. Defaults to the repository of each workspace.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("path")),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The directory containing the Rego policies in the repository. The policies are read from the commit being planned.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"enforcement_level": schema.StringAttribute{
+				MarkdownDescription: "What happens when a policy fails: `advisory` only reports the failure, `mandatory` blocks approving the plan.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("advisory"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("advisory", "mandatory"),
+				},
+			},
+			"workspace_ids": schema.SetAttribute{
+				MarkdownDescription: "The ids of the workspaces whose plans are checked.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *terradeskPolicySetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan terradeskPolicySetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policySet, err := r.client.CreatePolicySet(policySetInput(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating policy set",
+			"Could not create policy set, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(policySet.Id)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *terradeskPolicySetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state terradeskPolicySetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policySet, err := r.client.GetPolicySet(state.Id.ValueString())
+
+	if err != nil && err.Error() == "not found" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading policy set",
+			"Could not read policy set "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Id = types.StringValue(policySet.Id)
+	state.Name = types.StringValue(policySet.Name)
+	state.Repository = optionalStringValue(policySet.Repository)
+	state.Path = optionalStringValue(policySet.Path)
+	state.EnforcementLevel = types.StringValue(policySet.EnforcementLevel)
+	state.Policies = nil
+	state.WorkspaceIds = nil
+
+	if len(policySet.Policies) > 0 {
+		state.Policies = make(map[string]types.String, len(policySet.Policies))
+	}
+
+	for fileName, policy := range policySet.Policies {
+		state.Policies[fileName] = types.StringValue(policy)
+	}
+
+	for _, workspaceId := range policySet.WorkspaceIds {
+		state.WorkspaceIds = append(state.WorkspaceIds, types.StringValue(workspaceId))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *terradeskPolicySetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan terradeskPolicySetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdatePolicySet(plan.Id.ValueString(), policySetInput(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating policy set",
+			"Could not update policy set, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *terradeskPolicySetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state terradeskPolicySetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeletePolicySet(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting policy set",
+			"Could not delete policy set, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *terradeskPolicySetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*devhub.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *devhub.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *terradeskPolicySetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func policySetInput(plan terradeskPolicySetResourceModel) devhub.PolicySet {
+	input := devhub.PolicySet{
+		Id:               plan.Id.ValueString(),
+		Name:             plan.Name.ValueString(),
+		Policies:         make(map[string]string, len(plan.Policies)),
+		Repository:       plan.Repository.ValueString(),
+		Path:             plan.Path.ValueString(),
+		EnforcementLevel: plan.EnforcementLevel.ValueString(),
+		WorkspaceIds:     make([]string, 0, len(plan.WorkspaceIds)),
+	}
+
+	for fileName, policy := range plan.Policies {
+		input.Policies[fileName] = policy.ValueString()
+	}
+
+	for _, workspaceId := range plan.WorkspaceIds {
+		input.WorkspaceIds = append(input.WorkspaceIds, workspaceId.ValueString())
+	}
+
+	return input
+}
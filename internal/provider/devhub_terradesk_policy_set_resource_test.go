@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPolicySetResource(t *testing.T) {
+	name := fmt.Sprintf("policy_set_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Policies with syntax errors fail when planning
+			{
+				Config:      testAccPolicySetResourceConfig(name, "advisory", "deny contains msg if { true }"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Rego Policy"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccPolicySetResourceConfig(name, "advisory", "package terraform.policies\n\ndeny contains msg if {\n  input.resource_changes[_].change.actions[_] == \"delete\"\n  msg := \"resources can't be deleted\"\n}\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("devhub_terradesk_policy_set.test", "id"),
+					resource.TestCheckResourceAttr("devhub_terradesk_policy_set.test", "name", name),
+					resource.TestCheckResourceAttr("devhub_terradesk_policy_set.test", "policies.%", "1"),
+					resource.TestCheckResourceAttrSet("devhub_terradesk_policy_set.test", "policies.main.rego"),
+					resource.TestCheckResourceAttr("devhub_terradesk_policy_set.test", "enforcement_level", "advisory"),
+					resource.TestCheckTypeSetElemAttrPair("devhub_terradesk_policy_set.test", "workspace_ids.*", "devhub_terradesk_workspace.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devhub_terradesk_policy_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccPolicySetResourceConfig(name, "mandatory", "package terraform.policies\n\ndeny contains msg if {\n  input.resource_changes[_].change.actions[_] == \"delete\"\n  msg := \"resources can't be deleted\"\n}\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_policy_set.test", "enforcement_level", "mandatory"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPolicySetResourceConfig(name string, enforcementLevel string, policy string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_terradesk_workspace" "test" {
+  name         = %[1]q
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform"
+	docker_image = "hashicorp/terraform:1.10"
+}
+
+resource "devhub_terradesk_policy_set" "test" {
+	name              = %[1]q
+	enforcement_level = %[2]q
+	workspace_ids     = [devhub_terradesk_workspace.test.id]
+
+	policies = {
+		"main.rego" = %[3]q
+	}
+}
+`, name, enforcementLevel, policy)
+}
@@ -0,0 +1,297 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	devhub "terraform-provider-devhub/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &terradeskRunTriggerResource{}
+	_ resource.ResourceWithConfigure   = &terradeskRunTriggerResource{}
+	_ resource.ResourceWithImportState = &terradeskRunTriggerResource{}
+	_ resource.ResourceWithModifyPlan  = &terradeskRunTriggerResource{}
+)
+
+func TerradeskRunTriggerResource() resource.Resource {
+	return &terradeskRunTriggerResource{}
+}
+
+// terradeskRunTriggerResourceModel describes the resource data model.
+type terradeskRunTriggerResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	SourceWorkspaceId types.String `tfsdk:"source_workspace_id"`
+	TargetWorkspaceId types.String `tfsdk:"target_workspace_id"`
+}
+
+type terradeskRunTriggerResource struct {
+	client *devhub.Client
+}
+
+func (r *terradeskRunTriggerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terradesk_run_trigger"
+}
+
+func (r *terradeskRunTriggerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Starts a plan on the target workspace after an apply of the source workspace succeeds. " +
+			"A run trigger closing a cycle with the run triggers that already exist in DevHub is rejected when planning if both workspace ids are known, otherwise when it's created. " +
+			"A cycle made only of run triggers added in the same apply is detected when the last of them is created, after the others were already created.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Run trigger id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_workspace_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the workspace whose successful applies start a plan on the target workspace.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_workspace_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the workspace to plan after the source workspace applied.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan rejects new run triggers that would make workspaces trigger each other in a loop.
+func (r *terradeskRunTriggerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	// Nothing changes, an existing cycle is reported by the run trigger that created it
+	if req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var plan terradeskRunTriggerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SourceWorkspaceId.IsUnknown() || plan.TargetWorkspaceId.IsUnknown() {
+		return
+	}
+
+	// When replacing, the existing run trigger is deleted first so it can't be part of a cycle
+	var id types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	}
+
+	resp.Diagnostics.Append(r.checkCycle(id.ValueString(), plan)...)
+}
+
+func (r *terradeskRunTriggerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan terradeskRunTriggerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check again as other run triggers may have been created during the apply
+	resp.Diagnostics.Append(r.checkCycle("", plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	runTrigger, err := r.client.CreateRunTrigger(devhub.RunTrigger{
+		SourceWorkspaceId: plan.SourceWorkspaceId.ValueString(),
+		TargetWorkspaceId: plan.TargetWorkspaceId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating run trigger",
+			"Could not create run trigger, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(runTrigger.Id)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *terradeskRunTriggerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state terradeskRunTriggerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	runTrigger, err := r.client.GetRunTrigger(state.Id.ValueString())
+
+	if err != nil && err.Error() == "not found" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading run trigger",
+			"Could not read run trigger "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Id = types.StringValue(runTrigger.Id)
+	state.SourceWorkspaceId = types.StringValue(runTrigger.SourceWorkspaceId)
+	state.TargetWorkspaceId = types.StringValue(runTrigger.TargetWorkspaceId)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called as every attribute requires replacing the run trigger.
+func (r *terradeskRunTriggerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan terradeskRunTriggerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *terradeskRunTriggerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state terradeskRunTriggerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRunTrigger(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting run trigger",
+			"Could not delete run trigger, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *terradeskRunTriggerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*devhub.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *devhub.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *terradeskRunTriggerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// checkCycle adds an error if the run trigger would close a cycle with the existing
+// run triggers, ignoring the run trigger with the id ignoreId.
+func (r *terradeskRunTriggerResource) checkCycle(ignoreId string, plan terradeskRunTriggerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	runTriggers, err := r.client.ListRunTriggers()
+	if err != nil {
+		diags.AddError(
+			"Error reading run triggers",
+			"Could not list run triggers: "+err.Error(),
+		)
+		return diags
+	}
+
+	cycle := runTriggerCycle(runTriggers, ignoreId, plan.SourceWorkspaceId.ValueString(), plan.TargetWorkspaceId.ValueString())
+	if cycle != nil {
+		diags.AddAttributeError(
+			path.Root("target_workspace_id"),
+			"Run trigger cycle",
+			"The run trigger would make workspaces trigger each other in a loop: "+strings.Join(cycle, " -> "),
+		)
+	}
+
+	return diags
+}
+
+// runTriggerCycle returns the workspace ids of the cycle created by adding a run trigger
+// from source to target, starting and ending with source, or nil if there is none.
+func runTriggerCycle(runTriggers []devhub.RunTrigger, ignoreId string, source string, target string) []string {
+	targets := map[string][]string{}
+
+	for _, runTrigger := range runTriggers {
+		if runTrigger.Id != ignoreId {
+			targets[runTrigger.SourceWorkspaceId] = append(targets[runTrigger.SourceWorkspaceId], runTrigger.TargetWorkspaceId)
+		}
+	}
+
+	// Breadth first search from target back to source, remembering how each workspace was reached
+	previous := map[string]string{target: ""}
+	queue := []string{target}
+
+	for len(queue) > 0 {
+		workspaceId := queue[0]
+		queue = queue[1:]
+
+		if workspaceId == source {
+			var cycle []string
+			for ; workspaceId != ""; workspaceId = previous[workspaceId] {
+				cycle = append([]string{workspaceId}, cycle...)
+			}
+			return append([]string{source}, cycle...)
+		}
+
+		for _, next := range targets[workspaceId] {
+			if _, seen := previous[next]; !seen {
+				previous[next] = workspaceId
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return nil
+}
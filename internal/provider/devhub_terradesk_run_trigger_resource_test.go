@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRunTriggerResource(t *testing.T) {
+	name := fmt.Sprintf("workspace_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRunTriggerResourceConfig(name, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("devhub_terradesk_run_trigger.test", "id"),
+					resource.TestCheckResourceAttrPair("devhub_terradesk_run_trigger.test", "source_workspace_id", "devhub_terradesk_workspace.network", "id"),
+					resource.TestCheckResourceAttrPair("devhub_terradesk_run_trigger.test", "target_workspace_id", "devhub_terradesk_workspace.app", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devhub_terradesk_run_trigger.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Run triggers closing a cycle fail when planning
+			{
+				Config: testAccRunTriggerResourceConfig(name, `
+resource "devhub_terradesk_run_trigger" "cycle" {
+	source_workspace_id = devhub_terradesk_workspace.app.id
+	target_workspace_id = devhub_terradesk_workspace.network.id
+}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Run trigger cycle"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRunTriggerResourceConfig(name string, extra string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_terradesk_workspace" "network" {
+  name         = "%[1]s_network"
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform/network"
+	docker_image = "hashicorp/terraform:1.10"
+}
+
+resource "devhub_terradesk_workspace" "app" {
+  name         = "%[1]s_app"
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform/app"
+	docker_image = "hashicorp/terraform:1.10"
+}
+
+resource "devhub_terradesk_run_trigger" "test" {
+	source_workspace_id = devhub_terradesk_workspace.network.id
+	target_workspace_id = devhub_terradesk_workspace.app.id
+}
+%[2]s`, name, extra)
+}
@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	devhub "terraform-provider-devhub/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &terradeskVariableSetResource{}
+	_ resource.ResourceWithConfigure   = &terradeskVariableSetResource{}
+	_ resource.ResourceWithImportState = &terradeskVariableSetResource{}
+	_ resource.ResourceWithModifyPlan  = &terradeskVariableSetResource{}
+)
+
+func TerradeskVariableSetResource() resource.Resource {
+	return &terradeskVariableSetResource{}
+}
+
+// terradeskVariableSetResourceModel describes the resource data model.
+type terradeskVariableSetResourceModel struct {
+	Id          types.String           `tfsdk:"id"`
+	Name        types.String           `tfsdk:"name"`
+	Description types.String           `tfsdk:"description"`
+	EnvVars     map[string]envVarModel `tfsdk:"env_vars"`
+	Secrets     map[string]secretModel `tfsdk:"secrets"`
+}
+
+type terradeskVariableSetResource struct {
+	client *devhub.Client
+}
+
+func (r *terradeskVariableSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terradesk_variable_set"
+}
+
+func (r *terradeskVariableSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Env vars and secrets shared by TerraDesk workspaces. " +
+			"Add the id to `variable_set_ids` of a `devhub_terradesk_workspace` to use the variable set in its runs.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Variable set id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the variable set.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "What the variable set is used for.",
+				Optional:            true,
+			},
+			"env_vars": envVarsAttribute(),
+			"secrets":  secretsAttribute(),
+		},
+	}
+}
+
+// ModifyPlan plans updates for secrets whose configured value doesn't match the value hash from DevHub.
+func (r *terradeskVariableSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when destroying
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(planSecretValueHashes(ctx, req, resp)...)
+}
+
+func (r *terradeskVariableSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan terradeskVariableSetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// write-only attributes are only available in the config
+	var config terradeskVariableSetResourceModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variableSet, err := r.client.CreateVariableSet(variableSetInput(plan, config))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating variable set",
+			"Could not create variable set, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(variableSet.Id)
+
+	setEnvVarAndSecretIds(plan.EnvVars, plan.Secrets, variableSet.EnvVars, variableSet.Secrets)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *terradeskVariableSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state terradeskVariableSetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variableSet, err := r.client.GetVariableSet(state.Id.ValueString())
+
+	if err != nil && err.Error() == "not found" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading variable set",
+			"Could not read variable set "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Id = types.StringValue(variableSet.Id)
+	state.Name = types.StringValue(variableSet.Name)
+	state.Description = optionalStringValue(variableSet.Description)
+	state.EnvVars = newEnvVarModels(variableSet.EnvVars, state.EnvVars, true)
+	state.Secrets = newSecretModels(variableSet.Secrets, state.Secrets, true)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *terradeskVariableSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan terradeskVariableSetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// write-only attributes are only available in the config
+	var config terradeskVariableSetResourceModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variableSet, err := r.client.UpdateVariableSet(plan.Id.ValueString(), variableSetInput(plan, config))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating variable set",
+			"Could not update variable set, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	setEnvVarAndSecretIds(plan.EnvVars, plan.Secrets, variableSet.EnvVars, variableSet.Secrets)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *terradeskVariableSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state terradeskVariableSetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteVariableSet(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting variable set",
+			"Could not delete variable set, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *terradeskVariableSetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*devhub.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *devhub.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *terradeskVariableSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func variableSetInput(plan terradeskVariableSetResourceModel, config terradeskVariableSetResourceModel) devhub.VariableSet {
+	return devhub.VariableSet{
+		Id:          plan.Id.ValueString(),
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		EnvVars:     envVarsInput(plan.EnvVars),
+		Secrets:     secretsInput(plan.Secrets, config.Secrets),
+	}
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVariableSetResource(t *testing.T) {
+	name := fmt.Sprintf("variable_set_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVariableSetResourceConfig(name, "us-central1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("devhub_terradesk_variable_set.test", "id"),
+					resource.TestCheckResourceAttr("devhub_terradesk_variable_set.test", "name", name),
+					resource.TestCheckResourceAttr("devhub_terradesk_variable_set.test", "env_vars.%", "2"),
+					resource.TestCheckResourceAttr("devhub_terradesk_variable_set.test", "env_vars.GOOGLE_REGION.value", "us-central1"),
+					resource.TestCheckResourceAttrSet("devhub_terradesk_variable_set.test", "secrets.my_secret.value_hash"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "variable_set_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("devhub_terradesk_workspace.test", "variable_set_ids.0", "devhub_terradesk_variable_set.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "devhub_terradesk_variable_set.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secrets.my_secret.value"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccVariableSetResourceConfig(name, "europe-west1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_variable_set.test", "env_vars.GOOGLE_REGION.value", "europe-west1"),
+					resource.TestCheckResourceAttr("devhub_terradesk_variable_set.test", "secrets.my_secret.value", "secret-value"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccVariableSetResourceConfig(name string, region string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_terradesk_variable_set" "test" {
+	name        = %[1]q
+	description = "Shared settings"
+
+	env_vars = {
+		TF_PLUGIN_CACHE_DIR = {
+			value = "/tmp/terraform-plugin-cache"
+		}
+		GOOGLE_REGION = {
+			value = %[2]q
+		}
+	}
+
+	secrets = {
+		my_secret = {
+			value = "secret-value"
+		}
+	}
+}
+
+resource "devhub_terradesk_workspace" "test" {
+  name         = %[1]q
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform"
+	docker_image = "hashicorp/terraform:1.10"
+
+	variable_set_ids = [devhub_terradesk_variable_set.test.id]
+}
+`, name, region)
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	devhub "terraform-provider-devhub/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &workspaceDataSource{}
+	_ datasource.DataSourceWithConfigure = &workspaceDataSource{}
+)
+
+func NewWorkspaceDataSource() datasource.DataSource {
+	return &workspaceDataSource{}
+}
+
+type workspaceDataSource struct {
+	client *devhub.Client
+}
+
+type workspaceDataSourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Repository            types.String `tfsdk:"repository"`
+	Path                  types.String `tfsdk:"path"`
+	InitArgs              types.String `tfsdk:"init_args"`
+	DockerImage           types.String `tfsdk:"docker_image"`
+	Engine                types.String `tfsdk:"engine"`
+	TerraformVersion      types.String `tfsdk:"terraform_version"`
+	RunPlansAutomatically types.Bool   `tfsdk:"run_plans_automatically"`
+	AgentId               types.String `tfsdk:"agent_id"`
+	Locked                types.Bool   `tfsdk:"locked"`
+	LockReason            types.String `tfsdk:"lock_reason"`
+}
+
+func (d *workspaceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terradesk_workspace"
+}
+
+func (d *workspaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := workspaceDataSourceAttributes()
+
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Workspace id, either `id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRoot("id"),
+				path.MatchRoot("name"),
+			),
+		},
+	}
+
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the workspace, either `id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a TerraDesk workspace by id or name, for example to add a run trigger to a workspace managed elsewhere.",
+		Attributes:          attributes,
+	}
+}
+
+// workspaceDataSourceAttributes returns the attributes shared by the workspace and workspaces data sources.
+func workspaceDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Workspace id.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the workspace.",
+			Computed:            true,
+		},
+		"repository": schema.StringAttribute{
+			MarkdownDescription: "The GitHub repository of the workspace in the format `owner/name`.",
+			Computed:            true,
+		},
+		"path": schema.StringAttribute{
+			MarkdownDescription: "The file path of the workspace in the repository, null for the root of the repository.",
+			Computed:            true,
+		},
+		"init_args": schema.StringAttribute{
+			MarkdownDescription: "Args passed to the init command.",
+			Computed:            true,
+		},
+		"docker_image": schema.StringAttribute{
+			MarkdownDescription: "The docker image used for running commands.",
+			Computed:            true,
+		},
+		"engine": schema.StringAttribute{
+			MarkdownDescription: "The binary used to run commands: `terraform` or `opentofu`.",
+			Computed:            true,
+		},
+		"terraform_version": schema.StringAttribute{
+			MarkdownDescription: "The version constraint of the engine binary.",
+			Computed:            true,
+		},
+		"run_plans_automatically": schema.BoolAttribute{
+			MarkdownDescription: "Whether plans run automatically for PRs and pushes.",
+			Computed:            true,
+		},
+		"agent_id": schema.StringAttribute{
+			MarkdownDescription: "The agent id for the workspace.",
+			Computed:            true,
+		},
+		"locked": schema.BoolAttribute{
+			MarkdownDescription: "Whether the workspace is locked so no applies can run.",
+			Computed:            true,
+		},
+		"lock_reason": schema.StringAttribute{
+			MarkdownDescription: "Why the workspace is locked, null when it isn't locked.",
+			Computed:            true,
+		},
+	}
+}
+
+func newWorkspaceDataSourceModel(workspace *devhub.TerradeskWorkspace) workspaceDataSourceModel {
+	model := workspaceDataSourceModel{
+		Id:                    types.StringValue(workspace.Id),
+		Name:                  types.StringValue(workspace.Name),
+		Repository:            types.StringValue(workspace.Repository),
+		Path:                  optionalStringValue(workspace.Path),
+		InitArgs:              optionalStringValue(workspace.InitArgs),
+		DockerImage:           types.StringValue(workspace.DockerImage),
+		Engine:                types.StringValue(workspace.Engine),
+		TerraformVersion:      optionalStringValue(workspace.TerraformVersion),
+		RunPlansAutomatically: types.BoolValue(workspace.RunPlansAutomatically),
+		AgentId:               optionalStringValue(workspace.AgentId),
+		Locked:                types.BoolValue(workspace.Lock != nil),
+		LockReason:            types.StringNull(),
+	}
+
+	if workspace.Lock != nil {
+		model.LockReason = types.StringValue(workspace.Lock.Reason)
+	}
+
+	return model
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workspaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config workspaceDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var workspace *devhub.TerradeskWorkspace
+	var err error
+
+	if config.Id.ValueString() != "" {
+		workspace, err = d.client.GetWorkspace(config.Id.ValueString())
+	} else {
+		workspace, err = d.client.GetWorkspaceByName(config.Name.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Workspace",
+			fmt.Sprintf("Could not read workspace: %s", err.Error()),
+		)
+		return
+	}
+
+	state := newWorkspaceDataSourceModel(workspace)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workspaceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*devhub.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *devhub.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
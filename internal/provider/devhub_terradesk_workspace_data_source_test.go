@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWorkspaceDataSource(t *testing.T) {
+	name := fmt.Sprintf("workspace_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Lookup by name
+			{
+				Config: testAccWorkspaceDataSourceConfig(name, `name = devhub_terradesk_workspace.test.name`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.devhub_terradesk_workspace.test", "id", "devhub_terradesk_workspace.test", "id"),
+					resource.TestCheckResourceAttr("data.devhub_terradesk_workspace.test", "name", name),
+					resource.TestCheckResourceAttr("data.devhub_terradesk_workspace.test", "repository", "devhub-tools/devhub"),
+					resource.TestCheckResourceAttr("data.devhub_terradesk_workspace.test", "path", "terraform"),
+					resource.TestCheckResourceAttr("data.devhub_terradesk_workspace.test", "docker_image", "hashicorp/terraform:1.10"),
+					resource.TestCheckResourceAttr("data.devhub_terradesk_workspace.test", "locked", "false"),
+					resource.TestCheckNoResourceAttr("data.devhub_terradesk_workspace.test", "lock_reason"),
+				),
+			},
+			// Lookup by id
+			{
+				Config: testAccWorkspaceDataSourceConfig(name, `id = devhub_terradesk_workspace.test.id`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.devhub_terradesk_workspace.test", "id", "devhub_terradesk_workspace.test", "id"),
+					resource.TestCheckResourceAttr("data.devhub_terradesk_workspace.test", "name", name),
+				),
+			},
+			// Unknown workspaces fail
+			{
+				Config:      testAccWorkspaceDataSourceConfig(name, fmt.Sprintf(`name = "%s_missing"`, name)),
+				ExpectError: regexp.MustCompile("Error Reading Workspace"),
+			},
+		},
+	})
+}
+
+func testAccWorkspaceDataSourceConfig(name string, lookup string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_terradesk_workspace" "test" {
+  name     		 = %[1]q
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform"
+	docker_image = "hashicorp/terraform:1.10"
+}
+
+data "devhub_terradesk_workspace" "test" {
+	%[2]s
+}
+`, name, lookup)
+}
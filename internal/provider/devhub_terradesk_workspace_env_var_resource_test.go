@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccWorkspaceEnvVarResource(t *testing.T) {
+	name := fmt.Sprintf("workspace_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWorkspaceEnvVarResourceConfig(name, "app-value"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("devhub_terradesk_workspace_env_var.test", "id"),
+					resource.TestCheckResourceAttrPair("devhub_terradesk_workspace_env_var.test", "workspace_id", "devhub_terradesk_workspace.test", "id"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace_env_var.test", "name", "APP_ENV_VAR"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace_env_var.test", "value", "app-value"),
+					// the workspace keeps only tracking its own env vars
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "env_vars.%", "1"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "env_vars.ENV_VAR.value", "env-var-value"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devhub_terradesk_workspace_env_var.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWorkspaceItemImportStateIdFunc("devhub_terradesk_workspace_env_var.test"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccWorkspaceEnvVarResourceConfig(name, "updated-app-value"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace_env_var.test", "value", "updated-app-value"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "env_vars.%", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccWorkspaceItemImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["workspace_id"] + "/" + rs.Primary.Attributes["name"], nil
+	}
+}
+
+func testAccWorkspaceEnvVarResourceConfig(name string, value string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_terradesk_workspace" "test" {
+  name         = %[1]q
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform"
+	docker_image = "hashicorp/terraform:1.10"
+
+	authoritative_env_vars = false
+
+	env_vars = {
+		ENV_VAR = {
+			value = "env-var-value"
+		}
+	}
+}
+
+resource "devhub_terradesk_workspace_env_var" "test" {
+	workspace_id = devhub_terradesk_workspace.test.id
+	name         = "APP_ENV_VAR"
+	value        = %[2]q
+}
+`, name, value)
+}
@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	devhub "terraform-provider-devhub/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &terradeskWorkspaceLockResource{}
+	_ resource.ResourceWithConfigure   = &terradeskWorkspaceLockResource{}
+	_ resource.ResourceWithImportState = &terradeskWorkspaceLockResource{}
+)
+
+func TerradeskWorkspaceLockResource() resource.Resource {
+	return &terradeskWorkspaceLockResource{}
+}
+
+// terradeskWorkspaceLockResourceModel describes the resource data model.
+type terradeskWorkspaceLockResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	WorkspaceId types.String `tfsdk:"workspace_id"`
+	Reason      types.String `tfsdk:"reason"`
+	ForceUnlock types.Bool   `tfsdk:"force_unlock"`
+	LockedBy    types.String `tfsdk:"locked_by"`
+	LockedAt    types.String `tfsdk:"locked_at"`
+}
+
+type terradeskWorkspaceLockResource struct {
+	client *devhub.Client
+}
+
+func (r *terradeskWorkspaceLockResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terradesk_workspace_lock"
+}
+
+func (r *terradeskWorkspaceLockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Locks a TerraDesk workspace so no applies can run, for example during an incident freeze. " +
+			"The workspace is unlocked when the resource is destroyed. If the workspace was unlocked or locked again outside of Terraform the lock is planned to be recreated.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Lock id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the workspace to lock.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Why the workspace is locked, shown to users trying to apply.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"force_unlock": schema.BoolAttribute{
+				MarkdownDescription: "Whether to unlock the workspace on destroy even when the provider is configured with a different user or API key than `locked_by`, which requires admin permissions. " +
+					"Set it and apply before destroying, as DevHub otherwise only lets whoever locked the workspace unlock it.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"locked_by": schema.StringAttribute{
+				MarkdownDescription: "The user or API key that locked the workspace.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"locked_at": schema.StringAttribute{
+				MarkdownDescription: "When the workspace was locked.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *terradeskWorkspaceLockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan terradeskWorkspaceLockResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lock, err := r.client.LockWorkspace(plan.WorkspaceId.ValueString(), devhub.WorkspaceLock{
+		Reason: plan.Reason.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error locking workspace",
+			"Could not lock workspace, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(lock.Id)
+	plan.LockedBy = types.StringValue(lock.LockedBy)
+	plan.LockedAt = types.StringValue(lock.LockedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *terradeskWorkspaceLockResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state terradeskWorkspaceLockResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lock, err := r.client.GetWorkspaceLock(state.WorkspaceId.ValueString())
+
+	if err != nil && err.Error() == "not found" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading workspace lock",
+			"Could not read lock of workspace "+state.WorkspaceId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// The workspace was unlocked and locked again outside of Terraform, the id is null after an import
+	if !state.Id.IsNull() && state.Id.ValueString() != lock.Id {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Id = types.StringValue(lock.Id)
+	state.Reason = types.StringValue(lock.Reason)
+	state.LockedBy = types.StringValue(lock.LockedBy)
+	state.LockedAt = types.StringValue(lock.LockedAt)
+
+	// force_unlock is only known to the provider, default it after an import
+	if state.ForceUnlock.IsNull() {
+		state.ForceUnlock = types.BoolValue(false)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *terradeskWorkspaceLockResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan terradeskWorkspaceLockResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lock, err := r.client.UpdateWorkspaceLock(plan.WorkspaceId.ValueString(), devhub.WorkspaceLock{
+		Id:     plan.Id.ValueString(),
+		Reason: plan.Reason.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating workspace lock",
+			"Could not update workspace lock, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LockedBy = types.StringValue(lock.LockedBy)
+	plan.LockedAt = types.StringValue(lock.LockedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *terradeskWorkspaceLockResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state terradeskWorkspaceLockResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UnlockWorkspace(state.WorkspaceId.ValueString(), state.Id.ValueString(), state.ForceUnlock.ValueBool())
+
+	// The workspace was already unlocked or deleted
+	if err != nil && err.Error() == "not found" {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error unlocking workspace",
+			"Could not unlock workspace, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *terradeskWorkspaceLockResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*devhub.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *devhub.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState expects the id of the locked workspace.
+func (r *terradeskWorkspaceLockResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("workspace_id"), req, resp)
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccWorkspaceLockResource(t *testing.T) {
+	name := fmt.Sprintf("workspace_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWorkspaceLockResourceConfig(name, "Incident freeze", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("devhub_terradesk_workspace_lock.test", "id"),
+					resource.TestCheckResourceAttrPair("devhub_terradesk_workspace_lock.test", "workspace_id", "devhub_terradesk_workspace.test", "id"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace_lock.test", "reason", "Incident freeze"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace_lock.test", "force_unlock", "false"),
+					resource.TestCheckResourceAttrSet("devhub_terradesk_workspace_lock.test", "locked_by"),
+					resource.TestCheckResourceAttrSet("devhub_terradesk_workspace_lock.test", "locked_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devhub_terradesk_workspace_lock.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWorkspaceLockImportStateIdFunc("devhub_terradesk_workspace_lock.test"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccWorkspaceLockResourceConfig(name, "Incident freeze extended", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace_lock.test", "reason", "Incident freeze extended"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace_lock.test", "force_unlock", "true"),
+				),
+			},
+			// The lock is readable from the workspace data source
+			{
+				Config: testAccWorkspaceLockResourceConfig(name, "Incident freeze extended", true) + `
+data "devhub_terradesk_workspace" "test" {
+	id = devhub_terradesk_workspace_lock.test.workspace_id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devhub_terradesk_workspace.test", "locked", "true"),
+					resource.TestCheckResourceAttr("data.devhub_terradesk_workspace.test", "lock_reason", "Incident freeze extended"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccWorkspaceLockImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["workspace_id"], nil
+	}
+}
+
+func testAccWorkspaceLockResourceConfig(name string, reason string, forceUnlock bool) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_terradesk_workspace" "test" {
+  name         = %[1]q
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform"
+	docker_image = "hashicorp/terraform:1.10"
+}
+
+resource "devhub_terradesk_workspace_lock" "test" {
+	workspace_id = devhub_terradesk_workspace.test.id
+	reason       = %[2]q
+	force_unlock = %[3]t
+}
+`, name, reason, forceUnlock)
+}
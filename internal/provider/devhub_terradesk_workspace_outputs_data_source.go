@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	devhub "terraform-provider-devhub/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &workspaceOutputsDataSource{}
+	_ datasource.DataSourceWithConfigure = &workspaceOutputsDataSource{}
+)
+
+func NewWorkspaceOutputsDataSource() datasource.DataSource {
+	return &workspaceOutputsDataSource{}
+}
+
+type workspaceOutputsDataSource struct {
+	client *devhub.Client
+}
+
+type workspaceOutputsDataSourceModel struct {
+	WorkspaceId        types.String  `tfsdk:"workspace_id"`
+	Values             types.Dynamic `tfsdk:"values"`
+	NonsensitiveValues types.Dynamic `tfsdk:"nonsensitive_values"`
+}
+
+func (d *workspaceOutputsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terradesk_workspace_outputs"
+}
+
+func (d *workspaceOutputsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the outputs of the latest successful apply of a TerraDesk workspace. " +
+			"The workspace must have `global_remote_state` enabled or list the workspace running the plan in `remote_state_consumer_ids`.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the workspace to read the outputs of.",
+				Required:            true,
+			},
+			"values": schema.DynamicAttribute{
+				MarkdownDescription: "All outputs by name. Marked sensitive as it includes sensitive outputs.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"nonsensitive_values": schema.DynamicAttribute{
+				MarkdownDescription: "The outputs that aren't sensitive by name.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workspaceOutputsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state workspaceOutputsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputs, err := d.client.GetWorkspaceOutputs(state.WorkspaceId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Workspace Outputs",
+			fmt.Sprintf("Could not read outputs of workspace %s: %s", state.WorkspaceId.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	valueTypes := map[string]attr.Type{}
+	values := map[string]attr.Value{}
+	nonsensitiveTypes := map[string]attr.Type{}
+	nonsensitiveValues := map[string]attr.Value{}
+
+	for _, output := range outputs {
+		value, err := outputValue(output.Value)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Workspace Outputs",
+				fmt.Sprintf("Could not read output %s: %s", output.Name, err.Error()),
+			)
+			return
+		}
+
+		valueTypes[output.Name] = value.Type(ctx)
+		values[output.Name] = value
+
+		if !output.Sensitive {
+			nonsensitiveTypes[output.Name] = value.Type(ctx)
+			nonsensitiveValues[output.Name] = value
+		}
+	}
+
+	state.Values = types.DynamicValue(types.ObjectValueMust(valueTypes, values))
+	state.NonsensitiveValues = types.DynamicValue(types.ObjectValueMust(nonsensitiveTypes, nonsensitiveValues))
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workspaceOutputsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*devhub.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *devhub.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// outputValue converts the JSON value of an output. Lists become tuples and maps become
+// objects as the element types of outputs can differ. A missing value is null.
+func outputValue(raw json.RawMessage) (attr.Value, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	return jsonAttrValue(value)
+}
+
+func jsonAttrValue(value any) (attr.Value, error) {
+	switch value := value.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case bool:
+		return types.BoolValue(value), nil
+	case string:
+		return types.StringValue(value), nil
+	case json.Number:
+		// same precision as Terraform uses for numbers
+		number, _, err := big.ParseFloat(value.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, err
+		}
+		return types.NumberValue(number), nil
+	case []any:
+		elementTypes := make([]attr.Type, 0, len(value))
+		elements := make([]attr.Value, 0, len(value))
+
+		for _, element := range value {
+			converted, err := jsonAttrValue(element)
+			if err != nil {
+				return nil, err
+			}
+
+			elementTypes = append(elementTypes, converted.Type(context.Background()))
+			elements = append(elements, converted)
+		}
+
+		return types.TupleValueMust(elementTypes, elements), nil
+	case map[string]any:
+		attributeTypes := make(map[string]attr.Type, len(value))
+		attributes := make(map[string]attr.Value, len(value))
+
+		for name, attribute := range value {
+			converted, err := jsonAttrValue(attribute)
+			if err != nil {
+				return nil, err
+			}
+
+			attributeTypes[name] = converted.Type(context.Background())
+			attributes[name] = converted
+		}
+
+		return types.ObjectValueMust(attributeTypes, attributes), nil
+	default:
+		return nil, fmt.Errorf("unexpected type %T", value)
+	}
+}
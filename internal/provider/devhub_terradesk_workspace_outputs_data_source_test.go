@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	devhub "terraform-provider-devhub/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestOutputValue(t *testing.T) {
+	largeNumber, _, _ := big.ParseFloat("123456789012345678901234567890", 10, 512, big.ToNearestEven)
+
+	testCases := map[string]struct {
+		raw           string
+		expectedValue attr.Value
+		expectedError bool
+	}{
+		"missing": {
+			raw:           "",
+			expectedValue: types.StringNull(),
+		},
+		"null": {
+			raw:           "null",
+			expectedValue: types.StringNull(),
+		},
+		"bool": {
+			raw:           "true",
+			expectedValue: types.BoolValue(true),
+		},
+		"string": {
+			raw:           `"app"`,
+			expectedValue: types.StringValue("app"),
+		},
+		"number": {
+			raw:           "1.5",
+			expectedValue: types.NumberValue(big.NewFloat(1.5)),
+		},
+		"large number": {
+			raw:           "123456789012345678901234567890",
+			expectedValue: types.NumberValue(largeNumber),
+		},
+		"list": {
+			raw: `[1, "a"]`,
+			expectedValue: types.TupleValueMust(
+				[]attr.Type{types.NumberType, types.StringType},
+				[]attr.Value{types.NumberValue(big.NewFloat(1)), types.StringValue("a")},
+			),
+		},
+		"map": {
+			raw: `{"name": "app", "tags": {"team": "infra"}}`,
+			expectedValue: types.ObjectValueMust(
+				map[string]attr.Type{
+					"name": types.StringType,
+					"tags": types.ObjectType{AttrTypes: map[string]attr.Type{"team": types.StringType}},
+				},
+				map[string]attr.Value{
+					"name": types.StringValue("app"),
+					"tags": types.ObjectValueMust(map[string]attr.Type{"team": types.StringType}, map[string]attr.Value{"team": types.StringValue("infra")}),
+				},
+			),
+		},
+		"invalid": {
+			raw:           `{"name":`,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			value, err := outputValue([]byte(testCase.raw))
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatalf("expected an error, got: %s", value)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !value.Equal(testCase.expectedValue) {
+				t.Errorf("expected %s, got: %s", testCase.expectedValue, value)
+			}
+		})
+	}
+}
+
+func TestWorkspaceOutputsDataSourceRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/terradesk/workspaces/ws_1/outputs" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		fmt.Fprint(w, `[
+			{"name": "name", "value": "app", "sensitive": false},
+			{"name": "password", "value": "secret", "sensitive": true},
+			{"name": "pending", "sensitive": false}
+		]`)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	d := &workspaceOutputsDataSource{client: &devhub.Client{HostURL: server.URL, HTTPClient: server.Client()}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"workspace_id":        tftypes.NewValue(tftypes.String, "ws_1"),
+				"values":              tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+				"nonsensitive_values": tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+			}),
+		},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+
+	d.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var state workspaceOutputsDataSourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	values := state.Values.UnderlyingValue().(types.Object).Attributes()
+	nonsensitiveValues := state.NonsensitiveValues.UnderlyingValue().(types.Object).Attributes()
+
+	if len(values) != 3 || !values["password"].Equal(types.StringValue("secret")) {
+		t.Errorf("expected values to contain every output, got: %s", state.Values)
+	}
+
+	if _, ok := nonsensitiveValues["password"]; ok || len(nonsensitiveValues) != 2 {
+		t.Errorf("expected nonsensitive_values to exclude the sensitive output, got: %s", state.NonsensitiveValues)
+	}
+
+	if !nonsensitiveValues["name"].Equal(types.StringValue("app")) {
+		t.Errorf("expected the name output, got: %s", nonsensitiveValues["name"])
+	}
+
+	if !nonsensitiveValues["pending"].IsNull() {
+		t.Errorf("expected the output without a value to be null, got: %s", nonsensitiveValues["pending"])
+	}
+}
@@ -5,67 +5,167 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 	devhub "terraform-provider-devhub/internal/client"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &terradeskWorkspaceResource{}
-	_ resource.ResourceWithConfigure   = &terradeskWorkspaceResource{}
-	_ resource.ResourceWithImportState = &terradeskWorkspaceResource{}
+	_ resource.Resource                 = &terradeskWorkspaceResource{}
+	_ resource.ResourceWithConfigure    = &terradeskWorkspaceResource{}
+	_ resource.ResourceWithImportState  = &terradeskWorkspaceResource{}
+	_ resource.ResourceWithModifyPlan   = &terradeskWorkspaceResource{}
+	_ resource.ResourceWithUpgradeState = &terradeskWorkspaceResource{}
 )
 
+// uuidRegexp matches ids like the client and tenant ids of Azure.
+var uuidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
 func TerradeskWorkspaceResource() resource.Resource {
 	return &terradeskWorkspaceResource{}
 }
 
 // TerradeskWorkspaceResourceModel describes the resource data model.
 type terradeskWorkspaceResourceModel struct {
-	Id                    types.String           `tfsdk:"id"`
-	Name                  types.String           `tfsdk:"name"`
-	Repository            types.String           `tfsdk:"repository"`
-	InitArgs              types.String           `tfsdk:"init_args"`
-	Path                  types.String           `tfsdk:"path"`
-	RunPlansAutomatically types.Bool             `tfsdk:"run_plans_automatically"`
-	RequiredApprovals     types.Int64            `tfsdk:"required_approvals"`
-	DockerImage           types.String           `tfsdk:"docker_image"`
-	CpuRequests           types.String           `tfsdk:"cpu_requests"`
-	MemoryRequests        types.String           `tfsdk:"memory_requests"`
-	AgentId               types.String           `tfsdk:"agent_id"`
-	WorkloadIdentity      *workloadIdentityModel `tfsdk:"workload_identity"`
-	EnvVars               []envVarModel          `tfsdk:"env_vars"`
-	Secrets               []secretModel          `tfsdk:"secrets"`
+	Id                     types.String            `tfsdk:"id"`
+	Name                   types.String            `tfsdk:"name"`
+	Repository             types.String            `tfsdk:"repository"`
+	InitArgs               types.String            `tfsdk:"init_args"`
+	Path                   types.String            `tfsdk:"path"`
+	RunPlansAutomatically  types.Bool              `tfsdk:"run_plans_automatically"`
+	RequiredApprovals      types.Int64             `tfsdk:"required_approvals"`
+	ApprovalPolicy         *approvalPolicyModel    `tfsdk:"approval_policy"`
+	DockerImage            types.String            `tfsdk:"docker_image"`
+	Engine                 types.String            `tfsdk:"engine"`
+	TerraformVersion       types.String            `tfsdk:"terraform_version"`
+	CpuRequests            types.String            `tfsdk:"cpu_requests"`
+	MemoryRequests         types.String            `tfsdk:"memory_requests"`
+	CpuLimits              types.String            `tfsdk:"cpu_limits"`
+	MemoryLimits           types.String            `tfsdk:"memory_limits"`
+	EphemeralStorage       types.String            `tfsdk:"ephemeral_storage"`
+	NodeSelector           map[string]types.String `tfsdk:"node_selector"`
+	Tolerations            []tolerationModel       `tfsdk:"tolerations"`
+	ServiceAccountName     types.String            `tfsdk:"service_account_name"`
+	AgentId                types.String            `tfsdk:"agent_id"`
+	WorkloadIdentity       *workloadIdentityModel  `tfsdk:"workload_identity"`
+	VcsTrigger             *vcsTriggerModel        `tfsdk:"vcs_trigger"`
+	DriftDetection         *driftDetectionModel    `tfsdk:"drift_detection"`
+	Notifications          *notificationsModel     `tfsdk:"notifications"`
+	EnvVars                map[string]envVarModel  `tfsdk:"env_vars"`
+	Secrets                map[string]secretModel  `tfsdk:"secrets"`
+	VariableSetIds         []types.String          `tfsdk:"variable_set_ids"`
+	GlobalRemoteState      types.Bool              `tfsdk:"global_remote_state"`
+	RemoteStateConsumerIds []types.String          `tfsdk:"remote_state_consumer_ids"`
+	AuthoritativeEnvVars   types.Bool              `tfsdk:"authoritative_env_vars"`
+	AuthoritativeSecrets   types.Bool              `tfsdk:"authoritative_secrets"`
+}
+
+type approvalPolicyModel struct {
+	RequiredApprovals         types.Int64     `tfsdk:"required_approvals"`
+	DestroyRequiredApprovals  types.Int64     `tfsdk:"destroy_required_approvals"`
+	AllowCommitAuthorApproval types.Bool      `tfsdk:"allow_commit_author_approval"`
+	Approvers                 []approverModel `tfsdk:"approvers"`
+}
+
+type approverModel struct {
+	RoleId             types.String `tfsdk:"role_id"`
+	OrganizationUserId types.String `tfsdk:"organization_user_id"`
+}
+
+type tolerationModel struct {
+	Key               types.String `tfsdk:"key"`
+	Operator          types.String `tfsdk:"operator"`
+	Value             types.String `tfsdk:"value"`
+	Effect            types.String `tfsdk:"effect"`
+	TolerationSeconds types.Int64  `tfsdk:"toleration_seconds"`
 }
 
 type workloadIdentityModel struct {
-	Enabled             types.Bool   `tfsdk:"enabled"`
+	Gcp   *gcpWorkloadIdentityModel   `tfsdk:"gcp"`
+	Aws   *awsWorkloadIdentityModel   `tfsdk:"aws"`
+	Azure *azureWorkloadIdentityModel `tfsdk:"azure"`
+}
+
+type gcpWorkloadIdentityModel struct {
 	ServiceAccountEmail types.String `tfsdk:"service_account_email"`
 	Provider            types.String `tfsdk:"provider"`
 }
 
+type awsWorkloadIdentityModel struct {
+	RoleArn         types.String `tfsdk:"role_arn"`
+	SessionDuration types.String `tfsdk:"session_duration"`
+}
+
+type azureWorkloadIdentityModel struct {
+	ClientId types.String `tfsdk:"client_id"`
+	TenantId types.String `tfsdk:"tenant_id"`
+}
+
+type vcsTriggerModel struct {
+	Branch           types.String   `tfsdk:"branch"`
+	TriggerPaths     []types.String `tfsdk:"trigger_paths"`
+	SpeculativePlans types.Bool     `tfsdk:"speculative_plans"`
+	AutoApplyOnMerge types.Bool     `tfsdk:"auto_apply_on_merge"`
+	RequiredChecks   []types.String `tfsdk:"required_checks"`
+}
+
+type driftDetectionModel struct {
+	CronSchedule      types.String `tfsdk:"cron_schedule"`
+	SlackChannel      types.String `tfsdk:"slack_channel"`
+	CreateLinearIssue types.Bool   `tfsdk:"create_linear_issue"`
+}
+
+type notificationsModel struct {
+	PlanNeedsApproval *notificationTargetModel `tfsdk:"plan_needs_approval"`
+	ApplyFailed       *notificationTargetModel `tfsdk:"apply_failed"`
+	DriftDetected     *notificationTargetModel `tfsdk:"drift_detected"`
+}
+
+type notificationTargetModel struct {
+	SlackChannel types.String `tfsdk:"slack_channel"`
+	WebhookUrl   types.String `tfsdk:"webhook_url"`
+}
+
 type envVarModel struct {
 	Id    types.String `tfsdk:"id"`
-	Name  types.String `tfsdk:"name"`
 	Value types.String `tfsdk:"value"`
 }
 
 type secretModel struct {
-	Id    types.String `tfsdk:"id"`
-	Name  types.String `tfsdk:"name"`
-	Value types.String `tfsdk:"value"`
+	Id        types.String `tfsdk:"id"`
+	Value     types.String `tfsdk:"value"`
+	ValueWo   types.String `tfsdk:"value_wo"`
+	ValueHash types.String `tfsdk:"value_hash"`
 }
 
 type terradeskWorkspaceResource struct {
@@ -80,6 +180,7 @@ func (r *terradeskWorkspaceResource) Schema(_ context.Context, _ resource.Schema
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "TerraDesk workspace resource",
+		Version:             2,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -117,118 +218,545 @@ func (r *terradeskWorkspaceResource) Schema(_ context.Context, _ resource.Schema
 				Computed:            true,
 				Default:             int64default.StaticInt64(0),
 			},
+			"approval_policy": schema.SingleNestedAttribute{
+				MarkdownDescription: "Who has to approve plans before they can be applied. Can't be used with `required_approvals`.",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("required_approvals")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"required_approvals": schema.Int64Attribute{
+						MarkdownDescription: "How many approvals are required to apply plans that only create or update resources.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"destroy_required_approvals": schema.Int64Attribute{
+						MarkdownDescription: "How many approvals are required to apply plans that destroy or replace resources. Defaults to `required_approvals`.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"allow_commit_author_approval": schema.BoolAttribute{
+						MarkdownDescription: "Whether the author of the commit being planned can approve the plan.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"approvers": schema.SetNestedAttribute{
+						MarkdownDescription: "The roles and users that can approve plans. Anyone with access to the workspace can approve if not set.",
+						Optional:            true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"role_id": schema.StringAttribute{
+									MarkdownDescription: "The id of the role.",
+									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.ExactlyOneOf(
+											path.MatchRelative().AtParent().AtName("role_id"),
+											path.MatchRelative().AtParent().AtName("organization_user_id"),
+										),
+									},
+								},
+								"organization_user_id": schema.StringAttribute{
+									MarkdownDescription: "The id of the organization user.",
+									Optional:            true,
+								},
+							},
+						},
+					},
+				},
+			},
 			"docker_image": schema.StringAttribute{
-				MarkdownDescription: "The docker image to use for running commands, for example: hashicorp/terraform:1.10.",
-				Required:            true,
+				MarkdownDescription: "The docker image to use for running commands, for example: hashicorp/terraform:1.10. " +
+					"If not set DevHub picks a runner image matching `engine` and `terraform_version` when the workspace is created, either of them changes or `docker_image` is removed.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"engine": schema.StringAttribute{
+				MarkdownDescription: "The binary used to run commands when DevHub picks the runner image: `terraform` or `opentofu`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("terraform"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("terraform", "opentofu"),
+				},
+			},
+			"terraform_version": schema.StringAttribute{
+				MarkdownDescription: "A version constraint for the `engine` binary, for example `~> 1.10.0`. The latest matching version is used. Can't be used with `docker_image`.",
+				Optional:            true,
+				Validators: []validator.String{
+					versionConstraint(),
+					stringvalidator.ConflictsWith(path.MatchRoot("docker_image")),
+				},
 			},
 			"cpu_requests": schema.StringAttribute{
 				MarkdownDescription: "How much cpu should be requested for the pod scheduled by the job, see kubernetes docs for allowable values.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("100m"),
+				Validators: []validator.String{
+					kubernetesQuantity(),
+				},
 			},
 			"memory_requests": schema.StringAttribute{
 				MarkdownDescription: "How much memory should be requested for the pod scheduled by the job, see kubernetes docs for allowable values.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("512M"),
+				Validators: []validator.String{
+					kubernetesQuantity(),
+				},
+			},
+			"cpu_limits": schema.StringAttribute{
+				MarkdownDescription: "The maximum cpu the pod scheduled by the job can use, see kubernetes docs for allowable values. Not limited if not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					kubernetesQuantity(),
+				},
+			},
+			"memory_limits": schema.StringAttribute{
+				MarkdownDescription: "The maximum memory the pod scheduled by the job can use before it's killed, see kubernetes docs for allowable values. Not limited if not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					kubernetesQuantity(),
+				},
+			},
+			"ephemeral_storage": schema.StringAttribute{
+				MarkdownDescription: "How much ephemeral storage should be requested for the pod scheduled by the job, see kubernetes docs for allowable values.",
+				Optional:            true,
+				Validators: []validator.String{
+					kubernetesQuantity(),
+				},
+			},
+			"node_selector": schema.MapAttribute{
+				MarkdownDescription: "Node labels the pod scheduled by the job has to be scheduled on.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"tolerations": schema.ListNestedAttribute{
+				MarkdownDescription: "Tolerations of the pod scheduled by the job so it can be scheduled on tainted nodes.",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The taint key the toleration applies to. Matches all keys if not set, `operator` must be `Exists` in that case.",
+							Optional:            true,
+						},
+						"operator": schema.StringAttribute{
+							MarkdownDescription: "How the key is compared to the value: `Equal` or `Exists`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("Equal"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("Equal", "Exists"),
+							},
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The taint value the toleration matches. Should not be set if `operator` is `Exists`.",
+							Optional:            true,
+						},
+						"effect": schema.StringAttribute{
+							MarkdownDescription: "The taint effect to match: `NoSchedule`, `PreferNoSchedule` or `NoExecute`. Matches all effects if not set.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("NoSchedule", "PreferNoSchedule", "NoExecute"),
+							},
+						},
+						"toleration_seconds": schema.Int64Attribute{
+							MarkdownDescription: "How many seconds the pod stays bound to a node with a `NoExecute` taint. Tolerated forever if not set.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+			"service_account_name": schema.StringAttribute{
+				MarkdownDescription: "The kubernetes service account the pod scheduled by the job runs as.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 			"agent_id": schema.StringAttribute{
 				MarkdownDescription: "The agent id for the database.",
 				Optional:            true,
 			},
-			"workload_identity": schema.SingleNestedAttribute{
-				Optional: true,
+			"vcs_trigger": schema.SingleNestedAttribute{
+				MarkdownDescription: "Controls which pushes and pull requests start runs for the workspace.",
+				Optional:            true,
 				Attributes: map[string]schema.Attribute{
-					"enabled": schema.BoolAttribute{
-						MarkdownDescription: "Whether to enable Google workload identity to authenticate with Google services.",
+					"branch": schema.StringAttribute{
+						MarkdownDescription: "The branch to track, defaults to the default branch of the repository.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"trigger_paths": schema.ListAttribute{
+						MarkdownDescription: "Only start runs for changes to files matching one of these globs, relative to the root of the repository, for example `modules/**`. Defaults to changes in `path`.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.ValueStringsAre(globPattern()),
+						},
+					},
+					"speculative_plans": schema.BoolAttribute{
+						MarkdownDescription: "Whether to run speculative plans for pull requests.",
 						Optional:            true,
 						Computed:            true,
-						Default:             booldefault.StaticBool(false),
+						Default:             booldefault.StaticBool(true),
 					},
-					"service_account_email": schema.StringAttribute{
-						MarkdownDescription: "The service account email to use for workload identity.",
+					"auto_apply_on_merge": schema.BoolAttribute{
+						MarkdownDescription: "Whether to apply automatically when a pull request is merged into `branch`.",
 						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
 					},
-					"provider": schema.StringAttribute{
-						MarkdownDescription: "The workload identity provider to use: `projects/${PROJECT_NUMBER}/locations/global/workloadIdentityPools/${POOL}/providers/${PROVIDER}`",
+					"required_checks": schema.SetAttribute{
+						MarkdownDescription: "The GitHub checks that have to pass before applying automatically on merge.",
+						ElementType:         types.StringType,
 						Optional:            true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
 					},
 				},
 			},
-			"env_vars": schema.ListNestedAttribute{
-				Optional: true,
-				Computed: true,
-				Default: listdefault.StaticValue(
-					types.ListValueMust(
-						types.ObjectType{
-							AttrTypes: map[string]attr.Type{
-								"id":    types.StringType,
-								"name":  types.StringType,
-								"value": types.StringType,
-							},
-						},
-						[]attr.Value{},
-					),
-				),
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "Env var id.",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
-							},
-						},
-						"name": schema.StringAttribute{
-							MarkdownDescription: "Name to use for the env var.",
-							Required:            true,
+			"drift_detection": schema.SingleNestedAttribute{
+				MarkdownDescription: "Runs a plan on a schedule and notifies when the infrastructure drifted from the configuration.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"cron_schedule": schema.StringAttribute{
+						MarkdownDescription: "A cron expression evaluated using UTC time to run the drift detection plan (e.g. 0 6 * * *), or a nickname such as `@daily`.",
+						Required:            true,
+						Validators: []validator.String{
+							cronSchedule(),
 						},
-						"value": schema.StringAttribute{
-							MarkdownDescription: "Env var value.",
-							Required:            true,
+					},
+					"slack_channel": schema.StringAttribute{
+						MarkdownDescription: "The Slack channel to notify when drift is detected.",
+						Optional:            true,
+						Validators: []validator.String{
+							slackChannel(),
 						},
 					},
+					"create_linear_issue": schema.BoolAttribute{
+						MarkdownDescription: "Whether to open a Linear issue when drift is detected.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
 				},
 			},
-			"secrets": schema.ListNestedAttribute{
-				Optional: true,
-				Computed: true,
-				Default: listdefault.StaticValue(
-					types.ListValueMust(
-						types.ObjectType{
-							AttrTypes: map[string]attr.Type{
-								"id":    types.StringType,
-								"name":  types.StringType,
-								"value": types.StringType,
-							},
+			"notifications": schema.SingleNestedAttribute{
+				MarkdownDescription: "Where to send notifications about runs of the workspace.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"plan_needs_approval": schema.SingleNestedAttribute{
+						MarkdownDescription: "Notifies when a plan has changes and is waiting for approval.",
+						Optional:            true,
+						Attributes:          notificationTargetAttributes(),
+					},
+					"apply_failed": schema.SingleNestedAttribute{
+						MarkdownDescription: "Notifies when an apply failed.",
+						Optional:            true,
+						Attributes:          notificationTargetAttributes(),
+					},
+					"drift_detected": schema.SingleNestedAttribute{
+						MarkdownDescription: "Notifies when a drift detection plan found changes.",
+						Optional:            true,
+						Attributes:          notificationTargetAttributes(),
+					},
+				},
+			},
+			"workload_identity": schema.SingleNestedAttribute{
+				MarkdownDescription: "Authenticates runs with a cloud provider using workload identity. Exactly one of `gcp`, `aws` or `azure` must be set.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"gcp": schema.SingleNestedAttribute{
+						MarkdownDescription: "Google workload identity to authenticate with Google services.",
+						Optional:            true,
+						Validators: []validator.Object{
+							objectvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("gcp"),
+								path.MatchRelative().AtParent().AtName("aws"),
+								path.MatchRelative().AtParent().AtName("azure"),
+							),
 						},
-						[]attr.Value{},
-					),
-				),
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "Secret id.",
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.UseStateForUnknown(),
+						Attributes: map[string]schema.Attribute{
+							"service_account_email": schema.StringAttribute{
+								MarkdownDescription: "The service account email to use for workload identity.",
+								Required:            true,
+							},
+							"provider": schema.StringAttribute{
+								MarkdownDescription: "The workload identity provider to use: `projects/${PROJECT_NUMBER}/locations/global/workloadIdentityPools/${POOL}/providers/${PROVIDER}`",
+								Optional:            true,
 							},
 						},
-						"name": schema.StringAttribute{
-							MarkdownDescription: "Secret name.",
-							Required:            true,
+					},
+					"aws": schema.SingleNestedAttribute{
+						MarkdownDescription: "AWS web identity federation to assume an IAM role.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"role_arn": schema.StringAttribute{
+								MarkdownDescription: "The ARN of the IAM role to assume, for example `arn:aws:iam::123456789012:role/devhub`.",
+								Required:            true,
+								Validators: []validator.String{
+									stringvalidator.RegexMatches(
+										regexp.MustCompile(`^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$`),
+										"must be the ARN of an IAM role",
+									),
+								},
+							},
+							"session_duration": schema.StringAttribute{
+								MarkdownDescription: "How long the assumed role session lasts, between `15m` and `12h`. Defaults to the maximum session duration of the role.",
+								Optional:            true,
+								Validators: []validator.String{
+									positiveDuration(),
+									wholeSecondsDuration(),
+									durationBetween(15*time.Minute, 12*time.Hour),
+								},
+							},
 						},
-						"value": schema.StringAttribute{
-							MarkdownDescription: "Secret value.",
-							Required:            true,
-							Sensitive:           true,
+					},
+					"azure": schema.SingleNestedAttribute{
+						MarkdownDescription: "Microsoft Entra workload identity federation to authenticate with Azure services.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"client_id": schema.StringAttribute{
+								MarkdownDescription: "The client id of the app registration or managed identity.",
+								Required:            true,
+								Validators: []validator.String{
+									stringvalidator.RegexMatches(uuidRegexp, "must be a UUID"),
+								},
+							},
+							"tenant_id": schema.StringAttribute{
+								MarkdownDescription: "The id of the Microsoft Entra tenant.",
+								Required:            true,
+								Validators: []validator.String{
+									stringvalidator.RegexMatches(uuidRegexp, "must be a UUID"),
+								},
+							},
 						},
 					},
 				},
 			},
+			"variable_set_ids": schema.ListAttribute{
+				MarkdownDescription: "The ids of `devhub_terradesk_variable_set`s whose env vars and secrets are added to runs of the workspace. " +
+					"When names collide, variable sets later in the list take precedence over earlier ones and `env_vars` and `secrets` of the workspace take precedence over all variable sets.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"global_remote_state": schema.BoolAttribute{
+				MarkdownDescription: "Whether every workspace can read the state outputs of the workspace.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"remote_state_consumer_ids": schema.SetAttribute{
+				MarkdownDescription: "The ids of the workspaces allowed to read the state outputs of the workspace. Can't be used when `global_remote_state` is `true`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					conflictsWithEnabled(path.Root("global_remote_state")),
+				},
+			},
+			"env_vars": envVarsAttribute(),
+			"authoritative_env_vars": schema.BoolAttribute{
+				MarkdownDescription: "Whether `env_vars` contains all env vars of the workspace. Set to `false` to leave env vars that aren't in `env_vars` untouched, for example ones managed with `devhub_terradesk_workspace_env_var`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"authoritative_secrets": schema.BoolAttribute{
+				MarkdownDescription: "Whether `secrets` contains all secrets of the workspace. Set to `false` to leave secrets that aren't in `secrets` untouched, for example ones managed with `devhub_terradesk_workspace_secret`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"secrets": secretsAttribute(),
+		},
+	}
+}
+
+func (r *terradeskWorkspaceResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// version 0 stored env_vars and secrets as lists of objects with a name
+		0: {
+			StateUpgrader: func(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				upgradeTerradeskWorkspaceRawState(req, resp, upgradeTerradeskWorkspaceItemsV0, upgradeTerradeskWorkspaceWorkloadIdentityV1)
+			},
+		},
+		// version 1 only supported Google workload identity with an enabled flag
+		1: {
+			StateUpgrader: func(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				upgradeTerradeskWorkspaceRawState(req, resp, upgradeTerradeskWorkspaceWorkloadIdentityV1)
+			},
+		},
+	}
+}
+
+// upgradeTerradeskWorkspaceRawState applies the upgrades to the raw state in order. The
+// raw state is used so older versions don't need their full schema kept around.
+func upgradeTerradeskWorkspaceRawState(req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse, upgrades ...func(map[string]json.RawMessage) diag.Diagnostics) {
+	var state map[string]json.RawMessage
+	if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Workspace State",
+			"Could not parse the workspace state: "+err.Error(),
+		)
+		return
+	}
+
+	for _, upgrade := range upgrades {
+		resp.Diagnostics.Append(upgrade(state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	upgradedState, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Workspace State",
+			"Could not convert the workspace state: "+err.Error(),
+		)
+		return
+	}
+
+	resp.DynamicValue = &tfprotov6.DynamicValue{
+		JSON: upgradedState,
+	}
+}
+
+// upgradeTerradeskWorkspaceItemsV0 converts the env_vars and secrets lists to maps keyed by name.
+func upgradeTerradeskWorkspaceItemsV0(state map[string]json.RawMessage) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, attribute := range []string{"env_vars", "secrets"} {
+		raw, ok := state[attribute]
+		if !ok {
+			continue
+		}
+
+		var items []map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			diags.AddError("Unable to Upgrade Workspace State", fmt.Sprintf("Could not parse %s: %s", attribute, err.Error()))
+			return diags
+		}
+
+		// null stays null, empty secrets were only stored by the removed default
+		if items == nil || (attribute == "secrets" && len(items) == 0) {
+			state[attribute] = json.RawMessage("null")
+			continue
+		}
+
+		byName := make(map[string]map[string]json.RawMessage, len(items))
+
+		for _, item := range items {
+			var name string
+			if err := json.Unmarshal(item["name"], &name); err != nil {
+				diags.AddError("Unable to Upgrade Workspace State", fmt.Sprintf("Could not parse the name of an item in %s: %s", attribute, err.Error()))
+				return diags
+			}
+
+			if _, duplicate := byName[name]; duplicate {
+				diags.AddError("Unable to Upgrade Workspace State", fmt.Sprintf("The workspace state contains %q more than once in %s.", name, attribute))
+				return diags
+			}
+
+			delete(item, "name")
+			byName[name] = item
+		}
+
+		upgraded, err := json.Marshal(byName)
+		if err != nil {
+			diags.AddError("Unable to Upgrade Workspace State", fmt.Sprintf("Could not convert %s: %s", attribute, err.Error()))
+			return diags
+		}
+
+		state[attribute] = upgraded
+	}
+
+	return diags
+}
+
+// upgradeTerradeskWorkspaceWorkloadIdentityV1 moves the Google workload identity settings
+// into `gcp`. Disabled workload identities are removed as there is no flag anymore, with a
+// warning when they had settings.
+func upgradeTerradeskWorkspaceWorkloadIdentityV1(state map[string]json.RawMessage) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	raw, ok := state["workload_identity"]
+	if !ok {
+		return diags
+	}
+
+	var workloadIdentity *struct {
+		Enabled             *bool   `json:"enabled"`
+		ServiceAccountEmail *string `json:"service_account_email"`
+		Provider            *string `json:"provider"`
+	}
+	if err := json.Unmarshal(raw, &workloadIdentity); err != nil {
+		diags.AddError("Unable to Upgrade Workspace State", "Could not parse workload_identity: "+err.Error())
+		return diags
+	}
+
+	// null stays null
+	if workloadIdentity == nil {
+		return diags
+	}
+
+	if workloadIdentity.Enabled == nil || !*workloadIdentity.Enabled {
+		if workloadIdentity.ServiceAccountEmail != nil || workloadIdentity.Provider != nil {
+			diags.AddWarning(
+				"Disabled Workload Identity Removed",
+				"The workspace had a disabled workload identity which was removed from state as `enabled` no longer exists. "+
+					"Remove `workload_identity` from the configuration, or move its settings into `workload_identity.gcp` to enable it.",
+			)
+		}
+
+		state["workload_identity"] = json.RawMessage("null")
+		return diags
+	}
+
+	upgraded, err := json.Marshal(map[string]any{
+		"gcp": map[string]*string{
+			"service_account_email": workloadIdentity.ServiceAccountEmail,
+			"provider":              workloadIdentity.Provider,
 		},
+	})
+	if err != nil {
+		diags.AddError("Unable to Upgrade Workspace State", "Could not convert workload_identity: "+err.Error())
+		return diags
 	}
+
+	state["workload_identity"] = upgraded
+
+	return diags
 }
 
 func (r *terradeskWorkspaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -240,45 +768,49 @@ func (r *terradeskWorkspaceResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	var envVars []devhub.EnvVar
-	for _, envVar := range plan.EnvVars {
-		envVars = append(envVars, devhub.EnvVar{
-			Name:  envVar.Name.ValueString(),
-			Value: envVar.Value.ValueString(),
-		})
+	// write-only attributes are only available in the config
+	var config terradeskWorkspaceResourceModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	var secrets []devhub.Secret
-	for _, secret := range plan.Secrets {
-		secrets = append(secrets, devhub.Secret{
-			Name:  secret.Name.ValueString(),
-			Value: secret.Value.ValueString(),
-		})
-	}
+	envVars := envVarsInput(plan.EnvVars)
+	secrets := secretsInput(plan.Secrets, config.Secrets)
 
 	input := devhub.TerradeskWorkspace{
-		Name:                  plan.Name.ValueString(),
-		Repository:            plan.Repository.ValueString(),
-		InitArgs:              plan.InitArgs.ValueString(),
-		Path:                  plan.Path.ValueString(),
-		RunPlansAutomatically: plan.RunPlansAutomatically.ValueBool(),
-		RequiredApprovals:     int(plan.RequiredApprovals.ValueInt64()),
-		DockerImage:           plan.DockerImage.ValueString(),
-		CpuRequests:           plan.CpuRequests.ValueString(),
-		MemoryRequests:        plan.MemoryRequests.ValueString(),
-		AgentId:               plan.AgentId.ValueString(),
-		EnvVars:               envVars,
-		Secrets:               secrets,
-	}
-
-	if plan.WorkloadIdentity != nil {
-		input.WorkloadIdentity = &devhub.WorkloadIdentity{
-			Enabled:             plan.WorkloadIdentity.Enabled.ValueBool(),
-			ServiceAccountEmail: plan.WorkloadIdentity.ServiceAccountEmail.ValueString(),
-			Provider:            plan.WorkloadIdentity.Provider.ValueString(),
-		}
+		Name:                   plan.Name.ValueString(),
+		Repository:             plan.Repository.ValueString(),
+		InitArgs:               plan.InitArgs.ValueString(),
+		Path:                   plan.Path.ValueString(),
+		RunPlansAutomatically:  plan.RunPlansAutomatically.ValueBool(),
+		RequiredApprovals:      int(plan.RequiredApprovals.ValueInt64()),
+		ApprovalPolicy:         approvalPolicyInput(plan.ApprovalPolicy),
+		DockerImage:            plan.DockerImage.ValueString(),
+		Engine:                 plan.Engine.ValueString(),
+		TerraformVersion:       plan.TerraformVersion.ValueString(),
+		CpuRequests:            plan.CpuRequests.ValueString(),
+		MemoryRequests:         plan.MemoryRequests.ValueString(),
+		CpuLimits:              plan.CpuLimits.ValueString(),
+		MemoryLimits:           plan.MemoryLimits.ValueString(),
+		EphemeralStorage:       plan.EphemeralStorage.ValueString(),
+		NodeSelector:           nodeSelectorInput(plan.NodeSelector),
+		Tolerations:            tolerationsInput(plan.Tolerations),
+		ServiceAccountName:     plan.ServiceAccountName.ValueString(),
+		AgentId:                plan.AgentId.ValueString(),
+		EnvVars:                envVars,
+		Secrets:                secrets,
+		VariableSetIds:         variableSetIdsInput(plan.VariableSetIds),
+		GlobalRemoteState:      plan.GlobalRemoteState.ValueBool(),
+		RemoteStateConsumerIds: remoteStateConsumerIdsInput(plan.RemoteStateConsumerIds),
 	}
 
+	input.WorkloadIdentity = workloadIdentityInput(plan.WorkloadIdentity)
+	input.VcsTrigger = vcsTriggerInput(plan.VcsTrigger)
+	input.DriftDetection = driftDetectionInput(plan.DriftDetection)
+	input.Notifications = notificationsInput(plan.Notifications)
+
 	workspace, err := r.client.CreateWorkspace(input)
 
 	if err != nil {
@@ -290,14 +822,11 @@ func (r *terradeskWorkspaceResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	plan.Id = types.StringValue(workspace.Id)
+	plan.DockerImage = types.StringValue(workspace.DockerImage)
 
-	for index, envVar := range workspace.EnvVars {
-		plan.EnvVars[index].Id = types.StringValue(envVar.Id)
-	}
+	setEnvVarAndSecretIds(plan.EnvVars, plan.Secrets, workspace.EnvVars, workspace.Secrets)
 
-	for index, envVar := range workspace.Secrets {
-		plan.Secrets[index].Id = types.StringValue(envVar.Id)
-	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, dockerImageConfiguredKey, []byte(strconv.FormatBool(!config.DockerImage.IsNull())))...)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -307,23 +836,129 @@ func (r *terradeskWorkspaceResource) Create(ctx context.Context, req resource.Cr
 	}
 }
 
-func (r *terradeskWorkspaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// Get current state
-	var state terradeskWorkspaceResourceModel
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+// ModifyPlan plans the docker image picked by DevHub and updates for secrets whose
+// configured value doesn't match the value hash from DevHub.
+func (r *terradeskWorkspaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when destroying
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(planDockerImage(ctx, req, resp)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	workspace, err := r.client.GetWorkspace(state.Id.ValueString())
+	resp.Diagnostics.Append(planSecretValueHashes(ctx, req, resp)...)
+}
 
-	if err != nil && err.Error() == "not found" {
-		resp.State.RemoveResource(ctx)
-		return
+// planSecretValueHashes plans an update for secrets whose configured value doesn't match
+// the value hash from DevHub, which also catches secrets changed outside of Terraform.
+func planSecretValueHashes(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var secrets types.Map
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("secrets"), &secrets)...)
+	if diags.HasError() || secrets.IsNull() || secrets.IsUnknown() {
+		return diags
 	}
 
-	if err != nil {
+	var planSecrets map[string]secretModel
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("secrets"), &planSecrets)...)
+
+	var configSecrets map[string]secretModel
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("secrets"), &configSecrets)...)
+
+	var stateSecrets map[string]secretModel
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("secrets"), &stateSecrets)...)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	for name, secret := range planSecrets {
+		secret.ValueHash = types.StringUnknown()
+
+		configValue := configSecrets[name].Value
+		if configValue.IsNull() {
+			configValue = configSecrets[name].ValueWo
+		}
+
+		previous, found := stateSecrets[name]
+		if found && !configValue.IsUnknown() && secretValueMatches(previous.ValueHash.ValueString(), configValue.ValueString()) {
+			secret.ValueHash = previous.ValueHash
+		}
+
+		planSecrets[name] = secret
+	}
+
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("secrets"), planSecrets)...)
+
+	return diags
+}
+
+// planDockerImage marks the docker image DevHub picked as unknown when the engine or
+// terraform version changed or docker_image was removed from the configuration, otherwise
+// the image from state is kept.
+func planDockerImage(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if req.State.Raw.IsNull() {
+		return diags
+	}
+
+	var dockerImage types.String
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("docker_image"), &dockerImage)...)
+	if diags.HasError() || !dockerImage.IsNull() {
+		return diags
+	}
+
+	// read single attributes as the full model can't hold unknown env vars or secrets
+	var planEngine, stateEngine, planTerraformVersion, stateTerraformVersion types.String
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("engine"), &planEngine)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("engine"), &stateEngine)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("terraform_version"), &planTerraformVersion)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("terraform_version"), &stateTerraformVersion)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	configured, getDiags := req.Private.GetKey(ctx, dockerImageConfiguredKey)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if string(configured) == "true" || !planEngine.Equal(stateEngine) || !planTerraformVersion.Equal(stateTerraformVersion) {
+		diags.Append(resp.Plan.SetAttribute(ctx, path.Root("docker_image"), types.StringUnknown())...)
+	}
+
+	return diags
+}
+
+// dockerImageConfiguredKey is the private state key recording whether docker_image was
+// configured, as the state can't tell a configured image from one DevHub picked.
+const dockerImageConfiguredKey = "docker_image_configured"
+
+func (r *terradeskWorkspaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state terradeskWorkspaceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace, err := r.client.GetWorkspace(state.Id.ValueString())
+
+	if err != nil && err.Error() == "not found" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading workspace",
 			"Could not read workspace "+state.Id.ValueString()+": "+err.Error(),
@@ -345,18 +980,35 @@ func (r *terradeskWorkspaceResource) Read(ctx context.Context, req resource.Read
 
 	state.RunPlansAutomatically = types.BoolValue(workspace.RunPlansAutomatically)
 	state.RequiredApprovals = types.Int64Value(int64(workspace.RequiredApprovals))
+	state.ApprovalPolicy = newApprovalPolicyModel(workspace.ApprovalPolicy)
 	state.DockerImage = types.StringValue(workspace.DockerImage)
+	state.Engine = types.StringValue(workspace.Engine)
+	state.TerraformVersion = optionalStringValue(workspace.TerraformVersion)
 	state.CpuRequests = types.StringValue(workspace.CpuRequests)
 	state.MemoryRequests = types.StringValue(workspace.MemoryRequests)
+	state.CpuLimits = optionalStringValue(workspace.CpuLimits)
+	state.MemoryLimits = optionalStringValue(workspace.MemoryLimits)
+	state.EphemeralStorage = optionalStringValue(workspace.EphemeralStorage)
+	state.NodeSelector = newNodeSelectorModel(workspace.NodeSelector)
+	state.Tolerations = newTolerationModels(workspace.Tolerations)
+	state.ServiceAccountName = optionalStringValue(workspace.ServiceAccountName)
 
-	if workspace.WorkloadIdentity == nil {
-		state.WorkloadIdentity = nil
-	} else {
-		state.WorkloadIdentity = &workloadIdentityModel{
-			Enabled:             types.BoolValue(workspace.WorkloadIdentity.Enabled),
-			ServiceAccountEmail: types.StringValue(workspace.WorkloadIdentity.ServiceAccountEmail),
-			Provider:            types.StringValue(workspace.WorkloadIdentity.Provider),
-		}
+	state.WorkloadIdentity = newWorkloadIdentityModel(workspace.WorkloadIdentity, state.WorkloadIdentity)
+
+	state.VcsTrigger = newVcsTriggerModel(workspace.VcsTrigger)
+	state.DriftDetection = newDriftDetectionModel(workspace.DriftDetection)
+	state.Notifications = newNotificationsModel(workspace.Notifications)
+	state.VariableSetIds = nil
+
+	for _, variableSetId := range workspace.VariableSetIds {
+		state.VariableSetIds = append(state.VariableSetIds, types.StringValue(variableSetId))
+	}
+
+	state.GlobalRemoteState = types.BoolValue(workspace.GlobalRemoteState)
+	state.RemoteStateConsumerIds = nil
+
+	for _, workspaceId := range workspace.RemoteStateConsumerIds {
+		state.RemoteStateConsumerIds = append(state.RemoteStateConsumerIds, types.StringValue(workspaceId))
 	}
 
 	if workspace.AgentId != "" {
@@ -365,24 +1017,17 @@ func (r *terradeskWorkspaceResource) Read(ctx context.Context, req resource.Read
 		state.AgentId = types.StringNull()
 	}
 
-	if state.EnvVars == nil || len(state.EnvVars) != len(workspace.EnvVars) {
-		state.EnvVars = make([]envVarModel, len(workspace.EnvVars))
-	}
-
-	for index, envVar := range workspace.EnvVars {
-		state.EnvVars[index].Id = types.StringValue(envVar.Id)
-		state.EnvVars[index].Name = types.StringValue(envVar.Name)
-		state.EnvVars[index].Value = types.StringValue(envVar.Value)
+	// the authoritative flags are only known to the provider, default them after an import
+	if state.AuthoritativeEnvVars.IsNull() {
+		state.AuthoritativeEnvVars = types.BoolValue(true)
 	}
 
-	if state.Secrets == nil || len(state.Secrets) != len(workspace.Secrets) {
-		state.Secrets = make([]secretModel, len(workspace.Secrets))
+	if state.AuthoritativeSecrets.IsNull() {
+		state.AuthoritativeSecrets = types.BoolValue(true)
 	}
 
-	for index, secret := range workspace.Secrets {
-		state.Secrets[index].Id = types.StringValue(secret.Id)
-		state.Secrets[index].Name = types.StringValue(secret.Name)
-	}
+	state.EnvVars = newEnvVarModels(workspace.EnvVars, state.EnvVars, state.AuthoritativeEnvVars.ValueBool())
+	state.Secrets = newSecretModels(workspace.Secrets, state.Secrets, state.AuthoritativeSecrets.ValueBool())
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -401,48 +1046,76 @@ func (r *terradeskWorkspaceResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	var envVars []devhub.EnvVar
-	for _, envVar := range plan.EnvVars {
-		envVars = append(envVars, devhub.EnvVar{
-			Id:    envVar.Id.ValueString(),
-			Name:  envVar.Name.ValueString(),
-			Value: envVar.Value.ValueString(),
-		})
+	var state terradeskWorkspaceResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	var secrets []devhub.Secret
-	for _, secret := range plan.Secrets {
-		secrets = append(secrets, devhub.Secret{
-			Id:    secret.Id.ValueString(),
-			Name:  secret.Name.ValueString(),
-			Value: secret.Value.ValueString(),
-		})
+	var config terradeskWorkspaceResourceModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	envVars := envVarsInput(plan.EnvVars)
+	secrets := secretsInput(plan.Secrets, config.Secrets)
+
 	input := devhub.TerradeskWorkspace{
-		Name:                  plan.Name.ValueString(),
-		Repository:            plan.Repository.ValueString(),
-		InitArgs:              plan.InitArgs.ValueString(),
-		Path:                  plan.Path.ValueString(),
-		RunPlansAutomatically: plan.RunPlansAutomatically.ValueBool(),
-		RequiredApprovals:     int(plan.RequiredApprovals.ValueInt64()),
-		DockerImage:           plan.DockerImage.ValueString(),
-		CpuRequests:           plan.CpuRequests.ValueString(),
-		MemoryRequests:        plan.MemoryRequests.ValueString(),
-		AgentId:               plan.AgentId.ValueString(),
-		EnvVars:               envVars,
-		Secrets:               secrets,
-	}
-
-	if plan.WorkloadIdentity != nil {
-		input.WorkloadIdentity = &devhub.WorkloadIdentity{
-			Enabled:             plan.WorkloadIdentity.Enabled.ValueBool(),
-			ServiceAccountEmail: plan.WorkloadIdentity.ServiceAccountEmail.ValueString(),
-			Provider:            plan.WorkloadIdentity.Provider.ValueString(),
+		Name:                   plan.Name.ValueString(),
+		Repository:             plan.Repository.ValueString(),
+		InitArgs:               plan.InitArgs.ValueString(),
+		Path:                   plan.Path.ValueString(),
+		RunPlansAutomatically:  plan.RunPlansAutomatically.ValueBool(),
+		RequiredApprovals:      int(plan.RequiredApprovals.ValueInt64()),
+		ApprovalPolicy:         approvalPolicyInput(plan.ApprovalPolicy),
+		DockerImage:            plan.DockerImage.ValueString(),
+		Engine:                 plan.Engine.ValueString(),
+		TerraformVersion:       plan.TerraformVersion.ValueString(),
+		CpuRequests:            plan.CpuRequests.ValueString(),
+		MemoryRequests:         plan.MemoryRequests.ValueString(),
+		CpuLimits:              plan.CpuLimits.ValueString(),
+		MemoryLimits:           plan.MemoryLimits.ValueString(),
+		EphemeralStorage:       plan.EphemeralStorage.ValueString(),
+		NodeSelector:           nodeSelectorInput(plan.NodeSelector),
+		Tolerations:            tolerationsInput(plan.Tolerations),
+		ServiceAccountName:     plan.ServiceAccountName.ValueString(),
+		AgentId:                plan.AgentId.ValueString(),
+		EnvVars:                envVars,
+		Secrets:                secrets,
+		VariableSetIds:         variableSetIdsInput(plan.VariableSetIds),
+		GlobalRemoteState:      plan.GlobalRemoteState.ValueBool(),
+		RemoteStateConsumerIds: remoteStateConsumerIdsInput(plan.RemoteStateConsumerIds),
+	}
+
+	input.WorkloadIdentity = workloadIdentityInput(plan.WorkloadIdentity)
+	input.VcsTrigger = vcsTriggerInput(plan.VcsTrigger)
+	input.DriftDetection = driftDetectionInput(plan.DriftDetection)
+	input.Notifications = notificationsInput(plan.Notifications)
+
+	// The api replaces all env vars and secrets, so send the ones managed elsewhere as well
+	if !plan.AuthoritativeEnvVars.ValueBool() || !plan.AuthoritativeSecrets.ValueBool() {
+		current, err := r.client.GetWorkspace(plan.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating workspace",
+				"Could not read workspace "+plan.Id.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+
+		if !plan.AuthoritativeEnvVars.ValueBool() {
+			input.EnvVars = append(input.EnvVars, unmanagedEnvVars(current.EnvVars, plan.EnvVars, state.EnvVars)...)
+		}
+
+		if !plan.AuthoritativeSecrets.ValueBool() {
+			input.Secrets = append(input.Secrets, unmanagedSecrets(current.Secrets, plan.Secrets, state.Secrets)...)
 		}
 	}
 
-	_, err := r.client.UpdateWorkspace(plan.Id.ValueString(), input)
+	workspace, err := r.client.UpdateWorkspace(plan.Id.ValueString(), input)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating workspace",
@@ -451,6 +1124,12 @@ func (r *terradeskWorkspaceResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	plan.DockerImage = types.StringValue(workspace.DockerImage)
+
+	setEnvVarAndSecretIds(plan.EnvVars, plan.Secrets, workspace.EnvVars, workspace.Secrets)
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, dockerImageConfiguredKey, []byte(strconv.FormatBool(!config.DockerImage.IsNull())))...)
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -477,6 +1156,608 @@ func (r *terradeskWorkspaceResource) Delete(ctx context.Context, req resource.De
 	}
 }
 
+func workloadIdentityInput(workloadIdentity *workloadIdentityModel) *devhub.WorkloadIdentity {
+	if workloadIdentity == nil {
+		return nil
+	}
+
+	input := &devhub.WorkloadIdentity{}
+
+	if workloadIdentity.Gcp != nil {
+		input.Gcp = &devhub.GcpWorkloadIdentity{
+			ServiceAccountEmail: workloadIdentity.Gcp.ServiceAccountEmail.ValueString(),
+			Provider:            workloadIdentity.Gcp.Provider.ValueString(),
+		}
+	}
+
+	if workloadIdentity.Aws != nil {
+		input.Aws = &devhub.AwsWorkloadIdentity{
+			RoleArn: workloadIdentity.Aws.RoleArn.ValueString(),
+		}
+
+		if !workloadIdentity.Aws.SessionDuration.IsNull() {
+			// already checked by the positiveDuration validator
+			duration, _ := time.ParseDuration(workloadIdentity.Aws.SessionDuration.ValueString())
+			seconds := int64(duration.Seconds())
+			input.Aws.SessionDurationSeconds = &seconds
+		}
+	}
+
+	if workloadIdentity.Azure != nil {
+		input.Azure = &devhub.AzureWorkloadIdentity{
+			ClientId: workloadIdentity.Azure.ClientId.ValueString(),
+			TenantId: workloadIdentity.Azure.TenantId.ValueString(),
+		}
+	}
+
+	return input
+}
+
+// newWorkloadIdentityModel converts the api workload identity, keeping the aws
+// session_duration from state when it's the same duration written differently, e.g. `60m`.
+func newWorkloadIdentityModel(workloadIdentity *devhub.WorkloadIdentity, previous *workloadIdentityModel) *workloadIdentityModel {
+	if workloadIdentity == nil {
+		return nil
+	}
+
+	model := &workloadIdentityModel{}
+
+	if workloadIdentity.Gcp != nil {
+		model.Gcp = &gcpWorkloadIdentityModel{
+			ServiceAccountEmail: types.StringValue(workloadIdentity.Gcp.ServiceAccountEmail),
+			Provider:            optionalStringValue(workloadIdentity.Gcp.Provider),
+		}
+	}
+
+	if workloadIdentity.Aws != nil {
+		model.Aws = &awsWorkloadIdentityModel{
+			RoleArn:         types.StringValue(workloadIdentity.Aws.RoleArn),
+			SessionDuration: types.StringNull(),
+		}
+
+		if workloadIdentity.Aws.SessionDurationSeconds != nil {
+			duration := time.Duration(*workloadIdentity.Aws.SessionDurationSeconds) * time.Second
+			model.Aws.SessionDuration = types.StringValue(formatDuration(duration))
+
+			if previous != nil && previous.Aws != nil {
+				previousDuration, err := time.ParseDuration(previous.Aws.SessionDuration.ValueString())
+				if err == nil && previousDuration == duration {
+					model.Aws.SessionDuration = previous.Aws.SessionDuration
+				}
+			}
+		}
+	}
+
+	if workloadIdentity.Azure != nil {
+		model.Azure = &azureWorkloadIdentityModel{
+			ClientId: types.StringValue(workloadIdentity.Azure.ClientId),
+			TenantId: types.StringValue(workloadIdentity.Azure.TenantId),
+		}
+	}
+
+	return model
+}
+
+func vcsTriggerInput(vcsTrigger *vcsTriggerModel) *devhub.VcsTrigger {
+	if vcsTrigger == nil {
+		return nil
+	}
+
+	input := &devhub.VcsTrigger{
+		Branch:           vcsTrigger.Branch.ValueString(),
+		TriggerPaths:     make([]string, 0, len(vcsTrigger.TriggerPaths)),
+		SpeculativePlans: vcsTrigger.SpeculativePlans.ValueBool(),
+		AutoApplyOnMerge: vcsTrigger.AutoApplyOnMerge.ValueBool(),
+		RequiredChecks:   make([]string, 0, len(vcsTrigger.RequiredChecks)),
+	}
+
+	for _, triggerPath := range vcsTrigger.TriggerPaths {
+		input.TriggerPaths = append(input.TriggerPaths, triggerPath.ValueString())
+	}
+
+	for _, check := range vcsTrigger.RequiredChecks {
+		input.RequiredChecks = append(input.RequiredChecks, check.ValueString())
+	}
+
+	return input
+}
+
+func newVcsTriggerModel(vcsTrigger *devhub.VcsTrigger) *vcsTriggerModel {
+	if vcsTrigger == nil {
+		return nil
+	}
+
+	model := &vcsTriggerModel{
+		Branch:           types.StringNull(),
+		SpeculativePlans: types.BoolValue(vcsTrigger.SpeculativePlans),
+		AutoApplyOnMerge: types.BoolValue(vcsTrigger.AutoApplyOnMerge),
+	}
+
+	if vcsTrigger.Branch != "" {
+		model.Branch = types.StringValue(vcsTrigger.Branch)
+	}
+
+	for _, triggerPath := range vcsTrigger.TriggerPaths {
+		model.TriggerPaths = append(model.TriggerPaths, types.StringValue(triggerPath))
+	}
+
+	for _, check := range vcsTrigger.RequiredChecks {
+		model.RequiredChecks = append(model.RequiredChecks, types.StringValue(check))
+	}
+
+	return model
+}
+
+func approvalPolicyInput(approvalPolicy *approvalPolicyModel) *devhub.ApprovalPolicy {
+	if approvalPolicy == nil {
+		return nil
+	}
+
+	input := &devhub.ApprovalPolicy{
+		RequiredApprovals:         int(approvalPolicy.RequiredApprovals.ValueInt64()),
+		AllowCommitAuthorApproval: approvalPolicy.AllowCommitAuthorApproval.ValueBool(),
+		Permissions:               make([]*devhub.Permission, 0, len(approvalPolicy.Approvers)),
+	}
+
+	if !approvalPolicy.DestroyRequiredApprovals.IsNull() {
+		destroyRequiredApprovals := int(approvalPolicy.DestroyRequiredApprovals.ValueInt64())
+		input.DestroyRequiredApprovals = &destroyRequiredApprovals
+	}
+
+	for _, approver := range approvalPolicy.Approvers {
+		input.Permissions = append(input.Permissions, &devhub.Permission{
+			Permission:         "approve",
+			RoleId:             approver.RoleId.ValueString(),
+			OrganizationUserId: approver.OrganizationUserId.ValueString(),
+		})
+	}
+
+	return input
+}
+
+func newApprovalPolicyModel(approvalPolicy *devhub.ApprovalPolicy) *approvalPolicyModel {
+	if approvalPolicy == nil {
+		return nil
+	}
+
+	model := &approvalPolicyModel{
+		RequiredApprovals:         types.Int64Value(int64(approvalPolicy.RequiredApprovals)),
+		DestroyRequiredApprovals:  types.Int64Null(),
+		AllowCommitAuthorApproval: types.BoolValue(approvalPolicy.AllowCommitAuthorApproval),
+	}
+
+	if approvalPolicy.DestroyRequiredApprovals != nil {
+		model.DestroyRequiredApprovals = types.Int64Value(int64(*approvalPolicy.DestroyRequiredApprovals))
+	}
+
+	for _, permission := range approvalPolicy.Permissions {
+		model.Approvers = append(model.Approvers, approverModel{
+			RoleId:             optionalStringValue(permission.RoleId),
+			OrganizationUserId: optionalStringValue(permission.OrganizationUserId),
+		})
+	}
+
+	return model
+}
+
+func nodeSelectorInput(nodeSelector map[string]types.String) map[string]string {
+	if nodeSelector == nil {
+		return nil
+	}
+
+	input := make(map[string]string, len(nodeSelector))
+
+	for label, value := range nodeSelector {
+		input[label] = value.ValueString()
+	}
+
+	return input
+}
+
+func newNodeSelectorModel(nodeSelector map[string]string) map[string]types.String {
+	if len(nodeSelector) == 0 {
+		return nil
+	}
+
+	model := make(map[string]types.String, len(nodeSelector))
+
+	for label, value := range nodeSelector {
+		model[label] = types.StringValue(value)
+	}
+
+	return model
+}
+
+func tolerationsInput(tolerations []tolerationModel) []devhub.Toleration {
+	input := make([]devhub.Toleration, 0, len(tolerations))
+
+	for _, toleration := range tolerations {
+		input = append(input, devhub.Toleration{
+			Key:               toleration.Key.ValueString(),
+			Operator:          toleration.Operator.ValueString(),
+			Value:             toleration.Value.ValueString(),
+			Effect:            toleration.Effect.ValueString(),
+			TolerationSeconds: toleration.TolerationSeconds.ValueInt64Pointer(),
+		})
+	}
+
+	return input
+}
+
+func newTolerationModels(tolerations []devhub.Toleration) []tolerationModel {
+	var models []tolerationModel
+
+	for _, toleration := range tolerations {
+		models = append(models, tolerationModel{
+			Key:               optionalStringValue(toleration.Key),
+			Operator:          types.StringValue(toleration.Operator),
+			Value:             optionalStringValue(toleration.Value),
+			Effect:            optionalStringValue(toleration.Effect),
+			TolerationSeconds: types.Int64PointerValue(toleration.TolerationSeconds),
+		})
+	}
+
+	return models
+}
+
+// optionalStringValue returns null for empty strings which the api returns for unset values.
+func optionalStringValue(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+func driftDetectionInput(driftDetection *driftDetectionModel) *devhub.DriftDetection {
+	if driftDetection == nil {
+		return nil
+	}
+
+	return &devhub.DriftDetection{
+		CronSchedule:      driftDetection.CronSchedule.ValueString(),
+		SlackChannel:      driftDetection.SlackChannel.ValueString(),
+		CreateLinearIssue: driftDetection.CreateLinearIssue.ValueBool(),
+	}
+}
+
+func newDriftDetectionModel(driftDetection *devhub.DriftDetection) *driftDetectionModel {
+	if driftDetection == nil {
+		return nil
+	}
+
+	model := &driftDetectionModel{
+		CronSchedule:      types.StringValue(driftDetection.CronSchedule),
+		SlackChannel:      types.StringNull(),
+		CreateLinearIssue: types.BoolValue(driftDetection.CreateLinearIssue),
+	}
+
+	if driftDetection.SlackChannel != "" {
+		model.SlackChannel = types.StringValue(driftDetection.SlackChannel)
+	}
+
+	return model
+}
+
+// envVarsAttribute returns the env vars attribute shared by workspaces and variable sets.
+func envVarsAttribute() schema.MapNestedAttribute {
+	return schema.MapNestedAttribute{
+		MarkdownDescription: "Env vars by name.",
+		Optional:            true,
+		Computed:            true,
+		Default: mapdefault.StaticValue(
+			types.MapValueMust(
+				types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"id":    types.StringType,
+						"value": types.StringType,
+					},
+				},
+				map[string]attr.Value{},
+			),
+		),
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "Env var id.",
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"value": schema.StringAttribute{
+					MarkdownDescription: "Env var value.",
+					Required:            true,
+				},
+			},
+		},
+	}
+}
+
+// secretsAttribute returns the secrets attribute shared by workspaces and variable sets.
+func secretsAttribute() schema.MapNestedAttribute {
+	return schema.MapNestedAttribute{
+		MarkdownDescription: "Secrets by name.",
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "Secret id.",
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"value": schema.StringAttribute{
+					MarkdownDescription: "Secret value, either `value` or `value_wo` must be set.",
+					Optional:            true,
+					Sensitive:           true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(
+							path.MatchRelative().AtParent().AtName("value"),
+							path.MatchRelative().AtParent().AtName("value_wo"),
+						),
+					},
+				},
+				"value_wo": schema.StringAttribute{
+					MarkdownDescription: "Write-only alternative to `value` that is never stored in state, requires Terraform 1.11 or later. Changes are detected using `value_hash`.",
+					Optional:            true,
+					Sensitive:           true,
+					WriteOnly:           true,
+				},
+				"value_hash": schema.StringAttribute{
+					MarkdownDescription: "Salted hash of the secret value in DevHub, an update is planned when it doesn't match the configured value, for example after the secret was changed in DevHub.",
+					Computed:            true,
+					Sensitive:           true,
+				},
+			},
+		},
+	}
+}
+
+// notificationTargetAttributes returns the attributes shared by each notification event.
+func notificationTargetAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"slack_channel": schema.StringAttribute{
+			MarkdownDescription: "The Slack channel to post to.",
+			Optional:            true,
+			Validators: []validator.String{
+				slackChannel(),
+				stringvalidator.AtLeastOneOf(
+					path.MatchRelative().AtParent().AtName("slack_channel"),
+					path.MatchRelative().AtParent().AtName("webhook_url"),
+				),
+			},
+		},
+		"webhook_url": schema.StringAttribute{
+			MarkdownDescription: "A URL to send a POST request with the run details to.",
+			Optional:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^https://`), "must be an https URL"),
+			},
+		},
+	}
+}
+
+func notificationsInput(notifications *notificationsModel) *devhub.WorkspaceNotifications {
+	if notifications == nil {
+		return nil
+	}
+
+	return &devhub.WorkspaceNotifications{
+		PlanNeedsApproval: notificationTargetInput(notifications.PlanNeedsApproval),
+		ApplyFailed:       notificationTargetInput(notifications.ApplyFailed),
+		DriftDetected:     notificationTargetInput(notifications.DriftDetected),
+	}
+}
+
+func notificationTargetInput(target *notificationTargetModel) *devhub.NotificationTarget {
+	if target == nil {
+		return nil
+	}
+
+	return &devhub.NotificationTarget{
+		SlackChannel: target.SlackChannel.ValueString(),
+		WebhookUrl:   target.WebhookUrl.ValueString(),
+	}
+}
+
+func newNotificationsModel(notifications *devhub.WorkspaceNotifications) *notificationsModel {
+	if notifications == nil {
+		return nil
+	}
+
+	return &notificationsModel{
+		PlanNeedsApproval: newNotificationTargetModel(notifications.PlanNeedsApproval),
+		ApplyFailed:       newNotificationTargetModel(notifications.ApplyFailed),
+		DriftDetected:     newNotificationTargetModel(notifications.DriftDetected),
+	}
+}
+
+func newNotificationTargetModel(target *devhub.NotificationTarget) *notificationTargetModel {
+	if target == nil {
+		return nil
+	}
+
+	return &notificationTargetModel{
+		SlackChannel: optionalStringValue(target.SlackChannel),
+		WebhookUrl:   optionalStringValue(target.WebhookUrl),
+	}
+}
+
+func variableSetIdsInput(variableSetIds []types.String) []string {
+	input := make([]string, 0, len(variableSetIds))
+
+	for _, variableSetId := range variableSetIds {
+		input = append(input, variableSetId.ValueString())
+	}
+
+	return input
+}
+
+func remoteStateConsumerIdsInput(workspaceIds []types.String) []string {
+	input := make([]string, 0, len(workspaceIds))
+
+	for _, workspaceId := range workspaceIds {
+		input = append(input, workspaceId.ValueString())
+	}
+
+	return input
+}
+
+func envVarsInput(envVars map[string]envVarModel) []devhub.EnvVar {
+	var input []devhub.EnvVar
+
+	for _, name := range slices.Sorted(maps.Keys(envVars)) {
+		input = append(input, devhub.EnvVar{
+			Id:    envVars[name].Id.ValueString(),
+			Name:  name,
+			Value: envVars[name].Value.ValueString(),
+		})
+	}
+
+	return input
+}
+
+// secretsInput returns the secrets to send with the value from the config, as
+// `value_wo` is only available there.
+func secretsInput(secrets map[string]secretModel, configSecrets map[string]secretModel) []devhub.Secret {
+	var input []devhub.Secret
+
+	for _, name := range slices.Sorted(maps.Keys(secrets)) {
+		value := configSecrets[name].Value
+		if value.IsNull() {
+			value = configSecrets[name].ValueWo
+		}
+
+		input = append(input, devhub.Secret{
+			Id:    secrets[name].Id.ValueString(),
+			Name:  name,
+			Value: value.ValueString(),
+		})
+	}
+
+	return input
+}
+
+// setEnvVarAndSecretIds copies the ids and value hashes from the api response to the planned env vars and secrets.
+func setEnvVarAndSecretIds(envVars map[string]envVarModel, secrets map[string]secretModel, remoteEnvVars []devhub.EnvVar, remoteSecrets []devhub.Secret) {
+	for _, envVar := range remoteEnvVars {
+		if planned, ok := envVars[envVar.Name]; ok {
+			planned.Id = types.StringValue(envVar.Id)
+			envVars[envVar.Name] = planned
+		}
+	}
+
+	for _, secret := range remoteSecrets {
+		if planned, ok := secrets[secret.Name]; ok {
+			planned.Id = types.StringValue(secret.Id)
+			planned.ValueHash = types.StringValue(secret.ValueHash)
+			secrets[secret.Name] = planned
+		}
+	}
+}
+
+// secretValueMatches checks a value against a value hash in the format `<salt>$<hex sha256>`.
+func secretValueMatches(valueHash string, value string) bool {
+	salt, hash, found := strings.Cut(valueHash, "$")
+	if !found {
+		return false
+	}
+
+	sum := sha256.Sum256([]byte(salt + value))
+
+	return hex.EncodeToString(sum[:]) == hash
+}
+
+// newEnvVarModels converts the remote env vars. When env_vars isn't authoritative only
+// env vars in the previous state are kept so ones managed elsewhere don't show up as a diff.
+func newEnvVarModels(remote []devhub.EnvVar, previous map[string]envVarModel, authoritative bool) map[string]envVarModel {
+	if previous == nil && !authoritative {
+		return nil
+	}
+
+	envVars := make(map[string]envVarModel)
+
+	for _, envVar := range remote {
+		if _, managed := previous[envVar.Name]; !authoritative && !managed {
+			continue
+		}
+
+		envVars[envVar.Name] = envVarModel{
+			Id:    types.StringValue(envVar.Id),
+			Value: types.StringValue(envVar.Value),
+		}
+	}
+
+	return envVars
+}
+
+// newSecretModels converts the remote secrets the same way as newEnvVarModels, keeping
+// the values from state as they're never returned by the api.
+func newSecretModels(remote []devhub.Secret, previous map[string]secretModel, authoritative bool) map[string]secretModel {
+	// keep secrets null when it isn't configured
+	if previous == nil && (!authoritative || len(remote) == 0) {
+		return nil
+	}
+
+	secrets := make(map[string]secretModel)
+
+	for _, secret := range remote {
+		if _, managed := previous[secret.Name]; !authoritative && !managed {
+			continue
+		}
+
+		secrets[secret.Name] = secretModel{
+			Id:        types.StringValue(secret.Id),
+			Value:     previous[secret.Name].Value,
+			ValueWo:   types.StringNull(),
+			ValueHash: types.StringValue(secret.ValueHash),
+		}
+	}
+
+	return secrets
+}
+
+// unmanagedEnvVars returns the remote env vars that are neither planned nor were
+// previously managed by the workspace resource.
+func unmanagedEnvVars(remote []devhub.EnvVar, plan map[string]envVarModel, previous map[string]envVarModel) []devhub.EnvVar {
+	var envVars []devhub.EnvVar
+
+	for _, envVar := range remote {
+		_, planned := plan[envVar.Name]
+		_, managed := previous[envVar.Name]
+
+		if !planned && !managed {
+			envVars = append(envVars, envVar)
+		}
+	}
+
+	return envVars
+}
+
+// unmanagedSecrets returns the remote secrets that are neither planned nor were
+// previously managed by the workspace resource. They're sent without a value which
+// keeps their current value.
+func unmanagedSecrets(remote []devhub.Secret, plan map[string]secretModel, previous map[string]secretModel) []devhub.Secret {
+	var secrets []devhub.Secret
+
+	for _, secret := range remote {
+		_, planned := plan[secret.Name]
+		_, managed := previous[secret.Name]
+
+		if !planned && !managed {
+			secrets = append(secrets, devhub.Secret{
+				Id:   secret.Id,
+				Name: secret.Name,
+			})
+		}
+	}
+
+	return secrets
+}
+
 func (r *terradeskWorkspaceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
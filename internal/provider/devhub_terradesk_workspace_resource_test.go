@@ -1,11 +1,25 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccWorkspaceResource(t *testing.T) {
@@ -21,10 +35,10 @@ func TestAccWorkspaceResource(t *testing.T) {
 					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "repository", "devhub-tools/devhub"),
 					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "path", "terraform"),
 					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "docker_image", "hashicorp/terraform:1.10"),
-					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "env_vars.0.name", "ENV_VAR"),
-					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "env_vars.0.value", "env-var-value"),
-					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "secrets.0.name", "my_secret"),
-					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "secrets.0.value", "secret-value"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "env_vars.%", "2"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "env_vars.ENV_VAR.value", "env-var-value"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "env_vars.OTHER_ENV_VAR.value", "other-value"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "secrets.my_secret.value", "secret-value"),
 				),
 			},
 			// ImportState testing
@@ -32,7 +46,7 @@ func TestAccWorkspaceResource(t *testing.T) {
 				ResourceName:            "devhub_terradesk_workspace.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"secrets.0.value"},
+				ImportStateVerifyIgnore: []string{"secrets.my_secret.value"},
 			},
 			// Update and Read testing
 			{
@@ -43,10 +57,9 @@ func TestAccWorkspaceResource(t *testing.T) {
 					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "repository", "devhub-tools/devhub"),
 					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "path", "terraform"),
 					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "docker_image", "hashicorp/terraform:1.10"),
-					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "env_vars.0.name", "ENV_VAR"),
-					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "env_vars.0.value", "env-var-value"),
-					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "secrets.0.name", "my_secret"),
-					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "secrets.0.value", "secret-value"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "env_vars.ENV_VAR.value", "env-var-value"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "env_vars.OTHER_ENV_VAR.value", "other-value"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "secrets.my_secret.value", "secret-value"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -62,35 +75,595 @@ resource "devhub_terradesk_workspace" "test" {
 	path 				 = "terraform"
 	docker_image = "hashicorp/terraform:1.10"
 
-	env_vars = [
-		{
-			name = "ENV_VAR"
+	env_vars = {
+		ENV_VAR = {
 			value = "env-var-value"
 		}
-	]
+		OTHER_ENV_VAR = {
+			value = "other-value"
+		}
+	}
 
-	secrets = [
-		{
-			name = "my_secret"
+	secrets = {
+		my_secret = {
 			value = "secret-value"
 		}
-	]
+	}
 }
 `, name)
 }
 
+func TestAccWorkspaceWithSecretsResource(t *testing.T) {
+	name := fmt.Sprintf("workspace_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWorkspaceWithSecretsResourceConfig(name, "write-only-value"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "secrets.%", "2"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "secrets.my_secret.value", "secret-value"),
+					resource.TestCheckResourceAttrSet("devhub_terradesk_workspace.test", "secrets.my_secret.value_hash"),
+					resource.TestCheckNoResourceAttr("devhub_terradesk_workspace.test", "secrets.my_write_only_secret.value"),
+					resource.TestCheckNoResourceAttr("devhub_terradesk_workspace.test", "secrets.my_write_only_secret.value_wo"),
+					resource.TestCheckResourceAttrSet("devhub_terradesk_workspace.test", "secrets.my_write_only_secret.value_hash"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "devhub_terradesk_workspace.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secrets.my_secret.value"},
+			},
+			// A changed write-only value is detected with the value hash
+			{
+				Config: testAccWorkspaceWithSecretsResourceConfig(name, "updated-write-only-value"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("devhub_terradesk_workspace.test", tfjsonpath.New("secrets").AtMapKey("my_write_only_secret").AtMapKey("value_hash")),
+						plancheck.ExpectKnownValue("devhub_terradesk_workspace.test", tfjsonpath.New("secrets").AtMapKey("my_secret").AtMapKey("value_hash"), knownvalue.NotNull()),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("devhub_terradesk_workspace.test", "secrets.my_write_only_secret.value_hash"),
+				),
+			},
+			// Removing a secret deletes it
+			{
+				Config: testAccWorkspaceResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "secrets.%", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccWorkspaceWithSecretsResourceConfig(name string, writeOnlyValue string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_terradesk_workspace" "test" {
+  name     		 = %[1]q
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform"
+	docker_image = "hashicorp/terraform:1.10"
+
+	secrets = {
+		my_secret = {
+			value = "secret-value"
+		}
+		my_write_only_secret = {
+			value_wo = %[2]q
+		}
+	}
+}
+`, name, writeOnlyValue)
+}
+
+func TestAccWorkspaceWithVcsTriggerResource(t *testing.T) {
+	name := fmt.Sprintf("workspace_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWorkspaceWithVcsTriggerResourceConfig(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "vcs_trigger.branch", "main"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "vcs_trigger.trigger_paths.#", "2"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "vcs_trigger.trigger_paths.0", "terraform/**"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "vcs_trigger.trigger_paths.1", "modules/**"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "vcs_trigger.speculative_plans", "true"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "vcs_trigger.auto_apply_on_merge", "false"),
+					resource.TestCheckNoResourceAttr("devhub_terradesk_workspace.test", "vcs_trigger.required_checks.#"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devhub_terradesk_workspace.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccWorkspaceWithVcsTriggerResourceConfig(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "vcs_trigger.auto_apply_on_merge", "true"),
+					resource.TestCheckTypeSetElemAttr("devhub_terradesk_workspace.test", "vcs_trigger.required_checks.*", "lint"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccWorkspaceWithVcsTriggerResourceConfig(name string, autoApply bool) string {
+	requiredChecks := "null"
+	if autoApply {
+		requiredChecks = `["lint"]`
+	}
+
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_terradesk_workspace" "test" {
+  name         = %[1]q
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform"
+	docker_image = "hashicorp/terraform:1.10"
+
+	vcs_trigger = {
+		branch              = "main"
+		trigger_paths       = ["terraform/**", "modules/**"]
+		auto_apply_on_merge = %[2]t
+		required_checks     = %[3]s
+	}
+}
+`, name, autoApply, requiredChecks)
+}
+
+func TestAccWorkspaceWithDriftDetectionResource(t *testing.T) {
+	name := fmt.Sprintf("workspace_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid cron schedules fail when planning
+			{
+				Config:      testAccWorkspaceWithDriftDetectionResourceConfig(name, "0 25 * * *"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Cron Schedule"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccWorkspaceWithDriftDetectionResourceConfig(name, "0 6 * * *"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "drift_detection.cron_schedule", "0 6 * * *"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "drift_detection.slack_channel", "#infra"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "drift_detection.create_linear_issue", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devhub_terradesk_workspace.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccWorkspaceWithDriftDetectionResourceConfig(name, "0 */4 * * MON-FRI"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "drift_detection.cron_schedule", "0 */4 * * MON-FRI"),
+				),
+			},
+			// Nicknames can be used instead of the five fields
+			{
+				Config: testAccWorkspaceWithDriftDetectionResourceConfig(name, "@hourly"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "drift_detection.cron_schedule", "@hourly"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccWorkspaceWithDriftDetectionResourceConfig(name string, cronSchedule string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_terradesk_workspace" "test" {
+  name         = %[1]q
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform"
+	docker_image = "hashicorp/terraform:1.10"
+
+	drift_detection = {
+		cron_schedule       = %[2]q
+		slack_channel       = "#infra"
+		create_linear_issue = true
+	}
+}
+`, name, cronSchedule)
+}
+
+func TestAccWorkspaceWithNotificationsResource(t *testing.T) {
+	name := fmt.Sprintf("workspace_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid Slack channels fail when planning
+			{
+				Config:      testAccWorkspaceWithNotificationsResourceConfig(name, "#Infra Alerts"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Slack Channel"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccWorkspaceWithNotificationsResourceConfig(name, "#infra"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "notifications.plan_needs_approval.slack_channel", "#infra"),
+					resource.TestCheckNoResourceAttr("devhub_terradesk_workspace.test", "notifications.plan_needs_approval.webhook_url"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "notifications.apply_failed.slack_channel", "#infra"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "notifications.apply_failed.webhook_url", "https://example.com/hooks/terradesk"),
+					resource.TestCheckNoResourceAttr("devhub_terradesk_workspace.test", "notifications.drift_detected"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devhub_terradesk_workspace.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccWorkspaceWithNotificationsResourceConfig(name, "C0123456789"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "notifications.plan_needs_approval.slack_channel", "C0123456789"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "notifications.apply_failed.slack_channel", "C0123456789"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccWorkspaceWithNotificationsResourceConfig(name string, slackChannel string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_terradesk_workspace" "test" {
+  name         = %[1]q
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform"
+	docker_image = "hashicorp/terraform:1.10"
+
+	notifications = {
+		plan_needs_approval = {
+			slack_channel = %[2]q
+		}
+
+		apply_failed = {
+			slack_channel = %[2]q
+			webhook_url   = "https://example.com/hooks/terradesk"
+		}
+	}
+}
+`, name, slackChannel)
+}
+
+func TestAccWorkspaceWithRemoteStateResource(t *testing.T) {
+	name := fmt.Sprintf("workspace_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Consumers can't be listed when the state is shared with every workspace
+			{
+				Config:      testAccWorkspaceWithRemoteStateResourceConfig(name, "global_remote_state = true"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccWorkspaceWithRemoteStateResourceConfig(name, "global_remote_state = false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "global_remote_state", "false"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "remote_state_consumer_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("devhub_terradesk_workspace.test", "remote_state_consumer_ids.*", "devhub_terradesk_workspace.consumer", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devhub_terradesk_workspace.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccWorkspaceWithRemoteStateResourceConfig(name string, globalRemoteState string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_terradesk_workspace" "consumer" {
+  name         = "%[1]s_consumer"
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform/app"
+	docker_image = "hashicorp/terraform:1.10"
+}
+
+resource "devhub_terradesk_workspace" "test" {
+  name         = %[1]q
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform"
+	docker_image = "hashicorp/terraform:1.10"
+
+	%[2]s
+	remote_state_consumer_ids = [devhub_terradesk_workspace.consumer.id]
+}
+`, name, globalRemoteState)
+}
+
+func TestAccWorkspaceWithRunnerPodResource(t *testing.T) {
+	name := fmt.Sprintf("workspace_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid quantities fail when planning
+			{
+				Config:      testAccWorkspaceWithRunnerPodResourceConfig(name, "2 GB"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Quantity"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccWorkspaceWithRunnerPodResourceConfig(name, "2Gi"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "cpu_requests", "500m"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "cpu_limits", "2"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "memory_limits", "2Gi"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "ephemeral_storage", "10Gi"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "node_selector.%", "1"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "node_selector.pool", "terraform"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "tolerations.#", "1"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "tolerations.0.key", "dedicated"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "tolerations.0.operator", "Equal"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "tolerations.0.value", "terraform"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "tolerations.0.effect", "NoSchedule"),
+					resource.TestCheckNoResourceAttr("devhub_terradesk_workspace.test", "tolerations.0.toleration_seconds"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "service_account_name", "terraform"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devhub_terradesk_workspace.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccWorkspaceWithRunnerPodResourceConfig(name, "4Gi"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "memory_limits", "4Gi"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccWorkspaceWithRunnerPodResourceConfig(name string, memoryLimits string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_terradesk_workspace" "test" {
+  name         = %[1]q
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform"
+	docker_image = "hashicorp/terraform:1.10"
+
+	cpu_requests         = "500m"
+	cpu_limits           = "2"
+	memory_limits        = %[2]q
+	ephemeral_storage    = "10Gi"
+	service_account_name = "terraform"
+
+	node_selector = {
+		pool = "terraform"
+	}
+
+	tolerations = [
+		{
+			key    = "dedicated"
+			value  = "terraform"
+			effect = "NoSchedule"
+		}
+	]
+}
+`, name, memoryLimits)
+}
+
+func TestAccWorkspaceWithTerraformVersionResource(t *testing.T) {
+	name := fmt.Sprintf("workspace_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid version constraints fail when planning
+			{
+				Config:      testAccWorkspaceWithTerraformVersionResourceConfig(name, "latest"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Version Constraint"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccWorkspaceWithTerraformVersionResourceConfig(name, "~> 1.8.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "engine", "opentofu"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "terraform_version", "~> 1.8.0"),
+					resource.TestCheckResourceAttrSet("devhub_terradesk_workspace.test", "docker_image"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devhub_terradesk_workspace.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccWorkspaceWithTerraformVersionResourceConfig(name, "~> 1.9.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "terraform_version", "~> 1.9.0"),
+					resource.TestCheckResourceAttrSet("devhub_terradesk_workspace.test", "docker_image"),
+				),
+			},
+			// A configured docker image is used instead
+			{
+				Config: testAccWorkspaceWithDockerImageResourceConfig(name, `docker_image = "ghcr.io/opentofu/opentofu:1.9"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("devhub_terradesk_workspace.test", "terraform_version"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "docker_image", "ghcr.io/opentofu/opentofu:1.9"),
+				),
+			},
+			// DevHub picks an image again when docker_image is removed
+			{
+				Config: testAccWorkspaceWithDockerImageResourceConfig(name, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("devhub_terradesk_workspace.test", tfjsonpath.New("docker_image")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("devhub_terradesk_workspace.test", "docker_image"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccWorkspaceWithDockerImageResourceConfig(name string, dockerImage string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_terradesk_workspace" "test" {
+  name       = %[1]q
+  repository = "devhub-tools/devhub"
+	path 			 = "terraform"
+
+	engine = "opentofu"
+	%[2]s
+}
+`, name, dockerImage)
+}
+
+func testAccWorkspaceWithTerraformVersionResourceConfig(name string, terraformVersion string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_terradesk_workspace" "test" {
+  name       = %[1]q
+  repository = "devhub-tools/devhub"
+	path 			 = "terraform"
+
+	engine            = "opentofu"
+	terraform_version = %[2]q
+}
+`, name, terraformVersion)
+}
+
+func TestAccWorkspaceWithApprovalPolicyResource(t *testing.T) {
+	name := fmt.Sprintf("workspace_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWorkspaceWithApprovalPolicyResourceConfig(name, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "required_approvals", "0"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "approval_policy.required_approvals", "1"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "approval_policy.destroy_required_approvals", "2"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "approval_policy.allow_commit_author_approval", "false"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "approval_policy.approvers.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("devhub_terradesk_workspace.test", "approval_policy.approvers.*.role_id", "data.devhub_role.engineers", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "devhub_terradesk_workspace.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccWorkspaceWithApprovalPolicyResourceConfig(name, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "approval_policy.destroy_required_approvals", "3"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccWorkspaceWithApprovalPolicyResourceConfig(name string, destroyRequiredApprovals int) string {
+	return providerConfig + fmt.Sprintf(`
+data "devhub_role" "engineers" {
+  name = "Engineers"
+}
+
+resource "devhub_terradesk_workspace" "test" {
+  name         = %[1]q
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform"
+	docker_image = "hashicorp/terraform:1.10"
+
+	approval_policy = {
+		required_approvals         = 1
+		destroy_required_approvals = %[2]d
+
+		approvers = [
+			{
+				role_id = data.devhub_role.engineers.id
+			}
+		]
+	}
+}
+`, name, destroyRequiredApprovals)
+}
+
 func TestAccWorkspaceWithWorkloadIdentityResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Only one cloud can be configured
+			{
+				Config: testAccWorkspaceWithWorkloadIdentityResourceConfig(`
+		gcp = {
+			service_account_email = "devhub@google.com"
+		}
+		aws = {
+			role_arn = "arn:aws:iam::123456789012:role/devhub"
+		}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// AWS sessions last between 15 minutes and 12 hours
+			{
+				Config: testAccWorkspaceWithWorkloadIdentityResourceConfig(`
+		aws = {
+			role_arn         = "arn:aws:iam::123456789012:role/devhub"
+			session_duration = "13h"
+		}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Duration"),
+			},
 			// Create and Read testing
 			{
-				Config: testAccWorkspaceWithWorkloadIdentityResourceConfig("devhub@google.com"),
+				Config: testAccWorkspaceWithWorkloadIdentityResourceConfig(`
+		gcp = {
+			service_account_email = "devhub@google.com"
+			provider              = "projects/123456789/locations/global/workloadIdentityPools/pools/devhub/providers/devhub"
+		}
+`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "name", "my_workspace"),
-					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "workload_identity.enabled", "true"),
-					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "workload_identity.service_account_email", "devhub@google.com"),
-					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "workload_identity.provider", "projects/123456789/locations/global/workloadIdentityPools/pools/devhub/providers/devhub"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "workload_identity.gcp.service_account_email", "devhub@google.com"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "workload_identity.gcp.provider", "projects/123456789/locations/global/workloadIdentityPools/pools/devhub/providers/devhub"),
+					resource.TestCheckNoResourceAttr("devhub_terradesk_workspace.test", "workload_identity.aws"),
+					resource.TestCheckNoResourceAttr("devhub_terradesk_workspace.test", "workload_identity.azure"),
 				),
 			},
 			// ImportState testing
@@ -98,16 +671,33 @@ func TestAccWorkspaceWithWorkloadIdentityResource(t *testing.T) {
 				ResourceName:            "devhub_terradesk_workspace.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"secrets.0.value"},
+				ImportStateVerifyIgnore: []string{"secrets.my_secret.value"},
 			},
 			// Update and Read testing
 			{
-				Config: testAccWorkspaceWithWorkloadIdentityResourceConfig("serviceaccount@google.com"),
+				Config: testAccWorkspaceWithWorkloadIdentityResourceConfig(`
+		aws = {
+			role_arn         = "arn:aws:iam::123456789012:role/devhub"
+			session_duration = "1h"
+		}
+`),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "name", "my_workspace"),
-					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "workload_identity.enabled", "true"),
-					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "workload_identity.service_account_email", "serviceaccount@google.com"),
-					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "workload_identity.provider", "projects/123456789/locations/global/workloadIdentityPools/pools/devhub/providers/devhub"),
+					resource.TestCheckNoResourceAttr("devhub_terradesk_workspace.test", "workload_identity.gcp"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "workload_identity.aws.role_arn", "arn:aws:iam::123456789012:role/devhub"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "workload_identity.aws.session_duration", "1h"),
+				),
+			},
+			{
+				Config: testAccWorkspaceWithWorkloadIdentityResourceConfig(`
+		azure = {
+			client_id = "00000000-0000-0000-0000-000000000001"
+			tenant_id = "00000000-0000-0000-0000-000000000002"
+		}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("devhub_terradesk_workspace.test", "workload_identity.aws"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "workload_identity.azure.client_id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "workload_identity.azure.tenant_id", "00000000-0000-0000-0000-000000000002"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -115,7 +705,7 @@ func TestAccWorkspaceWithWorkloadIdentityResource(t *testing.T) {
 	})
 }
 
-func testAccWorkspaceWithWorkloadIdentityResourceConfig(email string) string {
+func testAccWorkspaceWithWorkloadIdentityResourceConfig(workloadIdentity string) string {
 	return providerConfig + fmt.Sprintf(`
 resource "devhub_terradesk_workspace" "test" {
   name     		 = "my_workspace"
@@ -123,11 +713,194 @@ resource "devhub_terradesk_workspace" "test" {
 	path 				 = "terraform"
 	docker_image = "hashicorp/terraform:1.10"
 
-	workload_identity = {
-		enabled             = true
-		service_account_email = %[1]q
-		provider            = "projects/123456789/locations/global/workloadIdentityPools/pools/devhub/providers/devhub"
+	workload_identity = {%[1]s	}
+}
+`, workloadIdentity)
+}
+
+func TestWorkspaceResourceUpgradeState(t *testing.T) {
+	testCases := map[string]struct {
+		version         int64
+		state           string
+		expectedState   string
+		expectedError   string
+		expectedWarning string
+	}{
+		"v0 null items": {
+			version:       0,
+			state:         `{"id":"ws_1","env_vars":null,"secrets":null}`,
+			expectedState: `{"id":"ws_1","env_vars":null,"secrets":null}`,
+		},
+		"v0 empty items": {
+			version:       0,
+			state:         `{"id":"ws_1","env_vars":[],"secrets":[]}`,
+			expectedState: `{"id":"ws_1","env_vars":{},"secrets":null}`,
+		},
+		"v0 items": {
+			version:       0,
+			state:         `{"id":"ws_1","env_vars":[{"id":"env_1","name":"ENV_VAR","value":"value"}],"secrets":[{"id":"sec_1","name":"my_secret","value":"secret","value_wo":null,"value_hash":"hash"}]}`,
+			expectedState: `{"id":"ws_1","env_vars":{"ENV_VAR":{"id":"env_1","value":"value"}},"secrets":{"my_secret":{"id":"sec_1","value":"secret","value_wo":null,"value_hash":"hash"}}}`,
+		},
+		"v0 duplicate items": {
+			version:       0,
+			state:         `{"id":"ws_1","env_vars":[{"id":"env_1","name":"ENV_VAR","value":"a"},{"id":"env_2","name":"ENV_VAR","value":"b"}]}`,
+			expectedError: `contains "ENV_VAR" more than once in env_vars`,
+		},
+		"v1 items": {
+			version:       1,
+			state:         `{"id":"ws_1","env_vars":{"ENV_VAR":{"id":"env_1","value":"value"}},"secrets":null}`,
+			expectedState: `{"id":"ws_1","env_vars":{"ENV_VAR":{"id":"env_1","value":"value"}},"secrets":null}`,
+		},
+		"v0 workload identity": {
+			version:       0,
+			state:         `{"id":"ws_1","workload_identity":{"enabled":true,"service_account_email":"terraform@project.iam.gserviceaccount.com","provider":"projects/1/locations/global/workloadIdentityPools/devhub/providers/devhub"}}`,
+			expectedState: `{"id":"ws_1","workload_identity":{"gcp":{"service_account_email":"terraform@project.iam.gserviceaccount.com","provider":"projects/1/locations/global/workloadIdentityPools/devhub/providers/devhub"}}}`,
+		},
+		"v1 null workload identity": {
+			version:       1,
+			state:         `{"id":"ws_1","workload_identity":null}`,
+			expectedState: `{"id":"ws_1","workload_identity":null}`,
+		},
+		"v1 workload identity": {
+			version:       1,
+			state:         `{"id":"ws_1","workload_identity":{"enabled":true,"service_account_email":"terraform@project.iam.gserviceaccount.com","provider":null}}`,
+			expectedState: `{"id":"ws_1","workload_identity":{"gcp":{"service_account_email":"terraform@project.iam.gserviceaccount.com","provider":null}}}`,
+		},
+		"v1 disabled workload identity": {
+			version:       1,
+			state:         `{"id":"ws_1","workload_identity":{"enabled":false,"service_account_email":null,"provider":null}}`,
+			expectedState: `{"id":"ws_1","workload_identity":null}`,
+		},
+		"v1 disabled workload identity with settings": {
+			version:         1,
+			state:           `{"id":"ws_1","workload_identity":{"enabled":false,"service_account_email":"terraform@project.iam.gserviceaccount.com","provider":null}}`,
+			expectedState:   `{"id":"ws_1","workload_identity":null}`,
+			expectedWarning: "Disabled Workload Identity Removed",
+		},
+	}
+
+	upgraders := (&terradeskWorkspaceResource{}).UpgradeState(context.Background())
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := fwresource.UpgradeStateRequest{
+				RawState: &tfprotov6.RawState{JSON: []byte(testCase.state)},
+			}
+			resp := &fwresource.UpgradeStateResponse{}
+
+			upgraders[testCase.version].StateUpgrader(context.Background(), req, resp)
+
+			if testCase.expectedError != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), testCase.expectedError) {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, resp.Diagnostics)
+				}
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			if testCase.expectedWarning != "" && (resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics.Warnings()[0].Summary() != testCase.expectedWarning) {
+				t.Errorf("expected warning %q, got: %v", testCase.expectedWarning, resp.Diagnostics)
+			}
+
+			if testCase.expectedWarning == "" && resp.Diagnostics.WarningsCount() > 0 {
+				t.Errorf("unexpected warning: %v", resp.Diagnostics)
+			}
+
+			var got, expected any
+			if err := json.Unmarshal(resp.DynamicValue.JSON, &got); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(testCase.expectedState), &expected); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("expected state %s, got: %s", testCase.expectedState, resp.DynamicValue.JSON)
+			}
+		})
 	}
 }
-`, email)
+
+func TestPlanDockerImage(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	TerradeskWorkspaceResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	// workspaceValue returns a workspace with every other attribute null
+	workspaceValue := func(values map[string]tftypes.Value) tftypes.Value {
+		attributes := make(map[string]tftypes.Value)
+		for name, attributeType := range objectType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+		for name, value := range values {
+			attributes[name] = value
+		}
+		return tftypes.NewValue(objectType, attributes)
+	}
+
+	unknownSecrets := tftypes.NewValue(objectType.AttributeTypes["secrets"], tftypes.UnknownValue)
+
+	testCases := map[string]struct {
+		plan                map[string]tftypes.Value
+		expectedDockerImage string
+	}{
+		"unchanged": {
+			plan: map[string]tftypes.Value{
+				"engine":       tftypes.NewValue(tftypes.String, "opentofu"),
+				"docker_image": tftypes.NewValue(tftypes.String, "ghcr.io/opentofu/opentofu:1.9"),
+				"secrets":      unknownSecrets,
+			},
+			expectedDockerImage: "ghcr.io/opentofu/opentofu:1.9",
+		},
+		"changed engine": {
+			plan: map[string]tftypes.Value{
+				"engine":       tftypes.NewValue(tftypes.String, "terraform"),
+				"docker_image": tftypes.NewValue(tftypes.String, "ghcr.io/opentofu/opentofu:1.9"),
+				"secrets":      unknownSecrets,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: workspaceValue(testCase.plan)}
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: workspaceValue(map[string]tftypes.Value{
+					"engine":  testCase.plan["engine"],
+					"secrets": unknownSecrets,
+				})},
+				Plan: plan,
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: workspaceValue(map[string]tftypes.Value{
+					"engine":       tftypes.NewValue(tftypes.String, "opentofu"),
+					"docker_image": tftypes.NewValue(tftypes.String, "ghcr.io/opentofu/opentofu:1.9"),
+				})},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+
+			if diags := planDockerImage(ctx, req, resp); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			var dockerImage types.String
+			if diags := resp.Plan.GetAttribute(ctx, path.Root("docker_image"), &dockerImage); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if testCase.expectedDockerImage == "" {
+				if !dockerImage.IsUnknown() {
+					t.Errorf("expected docker_image to be unknown, got: %s", dockerImage)
+				}
+				return
+			}
+
+			if dockerImage.ValueString() != testCase.expectedDockerImage {
+				t.Errorf("expected docker_image %s, got: %s", testCase.expectedDockerImage, dockerImage)
+			}
+		})
+	}
 }
@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	devhub "terraform-provider-devhub/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &terradeskWorkspaceSecretResource{}
+	_ resource.ResourceWithConfigure   = &terradeskWorkspaceSecretResource{}
+	_ resource.ResourceWithImportState = &terradeskWorkspaceSecretResource{}
+	_ resource.ResourceWithModifyPlan  = &terradeskWorkspaceSecretResource{}
+)
+
+func TerradeskWorkspaceSecretResource() resource.Resource {
+	return &terradeskWorkspaceSecretResource{}
+}
+
+// terradeskWorkspaceSecretResourceModel describes the resource data model.
+type terradeskWorkspaceSecretResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	WorkspaceId    types.String `tfsdk:"workspace_id"`
+	Name           types.String `tfsdk:"name"`
+	Value          types.String `tfsdk:"value"`
+	ValueWo        types.String `tfsdk:"value_wo"`
+	ValueWoVersion types.Int64  `tfsdk:"value_wo_version"`
+	ValueHash      types.String `tfsdk:"value_hash"`
+}
+
+type terradeskWorkspaceSecretResource struct {
+	client *devhub.Client
+}
+
+func (r *terradeskWorkspaceSecretResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terradesk_workspace_secret"
+}
+
+func (r *terradeskWorkspaceSecretResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A secret of a TerraDesk workspace managed separately from the workspace. " +
+			"Set `authoritative_secrets` to `false` on the `devhub_terradesk_workspace` resource so it doesn't remove secrets managed with this resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Secret id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the workspace.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Secret name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Secret value, either `value` or `value_wo` must be set.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("value"),
+						path.MatchRoot("value_wo"),
+					),
+				},
+			},
+			"value_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only alternative to `value` that is never stored in state, requires Terraform 1.11 or later. Changes are detected using `value_hash`.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"value_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Change this value to send `value_wo` to DevHub again.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("value_wo")),
+				},
+			},
+			"value_hash": schema.StringAttribute{
+				MarkdownDescription: "Salted hash of the secret value in DevHub, an update is planned when it doesn't match the configured value, for example after the secret was changed in DevHub.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+// ModifyPlan plans an update when the configured value doesn't match the value hash from
+// DevHub, which also catches secrets changed outside of Terraform.
+func (r *terradeskWorkspaceSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when creating or destroying
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config, state terradeskWorkspaceSecretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configValue := config.Value
+	if configValue.IsNull() {
+		configValue = config.ValueWo
+	}
+
+	valueHash := types.StringUnknown()
+	if !configValue.IsUnknown() && plan.ValueWoVersion.Equal(state.ValueWoVersion) && secretValueMatches(state.ValueHash.ValueString(), configValue.ValueString()) {
+		valueHash = state.ValueHash
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value_hash"), valueHash)...)
+}
+
+func (r *terradeskWorkspaceSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config terradeskWorkspaceSecretResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.client.CreateWorkspaceSecret(plan.WorkspaceId.ValueString(), devhub.Secret{
+		Name:  plan.Name.ValueString(),
+		Value: workspaceSecretValue(config),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating workspace secret",
+			"Could not create workspace secret, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(secret.Id)
+	plan.ValueHash = types.StringValue(secret.ValueHash)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *terradeskWorkspaceSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state terradeskWorkspaceSecretResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.client.GetWorkspaceSecret(state.WorkspaceId.ValueString(), state.Name.ValueString())
+
+	if err != nil && err.Error() == "not found" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading workspace secret",
+			"Could not read workspace secret "+state.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// the value is never returned by the api so keep the one from state
+	state.Id = types.StringValue(secret.Id)
+	state.Name = types.StringValue(secret.Name)
+	state.ValueHash = types.StringValue(secret.ValueHash)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *terradeskWorkspaceSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config terradeskWorkspaceSecretResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.client.UpdateWorkspaceSecret(plan.WorkspaceId.ValueString(), plan.Name.ValueString(), devhub.Secret{
+		Id:    plan.Id.ValueString(),
+		Name:  plan.Name.ValueString(),
+		Value: workspaceSecretValue(config),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating workspace secret",
+			"Could not update workspace secret, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ValueHash = types.StringValue(secret.ValueHash)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *terradeskWorkspaceSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state terradeskWorkspaceSecretResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteWorkspaceSecret(state.WorkspaceId.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting workspace secret",
+			"Could not delete workspace secret, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *terradeskWorkspaceSecretResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*devhub.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *devhub.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState expects an id in the format `workspace_id/NAME`. The value can't be imported
+// and is only updated on the next apply when it doesn't match `value_hash`.
+func (r *terradeskWorkspaceSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspaceId, name, found := strings.Cut(req.ID, "/")
+
+	if !found || workspaceId == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: workspace_id/NAME. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// workspaceSecretValue returns the value to send from the config, as `value_wo` is only
+// available there.
+func workspaceSecretValue(config terradeskWorkspaceSecretResourceModel) string {
+	if config.Value.IsNull() {
+		return config.ValueWo.ValueString()
+	}
+
+	return config.Value.ValueString()
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccWorkspaceSecretResource(t *testing.T) {
+	name := fmt.Sprintf("workspace_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWorkspaceSecretResourceConfig(name, "app-secret"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("devhub_terradesk_workspace_secret.test", "id"),
+					resource.TestCheckResourceAttrPair("devhub_terradesk_workspace_secret.test", "workspace_id", "devhub_terradesk_workspace.test", "id"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace_secret.test", "name", "app_secret"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace_secret.test", "value", "app-secret"),
+					resource.TestCheckResourceAttrSet("devhub_terradesk_workspace_secret.test", "value_hash"),
+					// the workspace keeps only tracking its own secrets
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "secrets.%", "1"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "secrets.my_secret.value", "secret-value"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "devhub_terradesk_workspace_secret.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value"},
+				ImportStateIdFunc:       testAccWorkspaceItemImportStateIdFunc("devhub_terradesk_workspace_secret.test"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccWorkspaceSecretResourceConfig(name, "updated-app-secret"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace_secret.test", "value", "updated-app-secret"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace.test", "secrets.%", "1"),
+				),
+			},
+			// Switching to a write-only value keeps it out of state
+			{
+				Config: testAccWorkspaceSecretWriteOnlyResourceConfig(name, "write-only-app-secret", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("devhub_terradesk_workspace_secret.test", "value"),
+					resource.TestCheckNoResourceAttr("devhub_terradesk_workspace_secret.test", "value_wo"),
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace_secret.test", "value_wo_version", "1"),
+					resource.TestCheckResourceAttrSet("devhub_terradesk_workspace_secret.test", "value_hash"),
+				),
+			},
+			// An unchanged write-only value doesn't plan an update
+			{
+				Config: testAccWorkspaceSecretWriteOnlyResourceConfig(name, "write-only-app-secret", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// A changed write-only value is detected with the value hash
+			{
+				Config: testAccWorkspaceSecretWriteOnlyResourceConfig(name, "updated-write-only-app-secret", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("devhub_terradesk_workspace_secret.test", tfjsonpath.New("value_hash")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("devhub_terradesk_workspace_secret.test", "value_hash"),
+				),
+			},
+			// Changing value_wo_version sends the write-only value again
+			{
+				Config: testAccWorkspaceSecretWriteOnlyResourceConfig(name, "updated-write-only-app-secret", 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("devhub_terradesk_workspace_secret.test", tfjsonpath.New("value_hash")),
+						plancheck.ExpectKnownValue("devhub_terradesk_workspace_secret.test", tfjsonpath.New("value_wo_version"), knownvalue.Int64Exact(2)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("devhub_terradesk_workspace_secret.test", "value_wo_version", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccWorkspaceSecretResourceConfig(name string, value string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_terradesk_workspace" "test" {
+  name         = %[1]q
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform"
+	docker_image = "hashicorp/terraform:1.10"
+
+	authoritative_secrets = false
+
+	secrets = {
+		my_secret = {
+			value = "secret-value"
+		}
+	}
+}
+
+resource "devhub_terradesk_workspace_secret" "test" {
+	workspace_id = devhub_terradesk_workspace.test.id
+	name         = "app_secret"
+	value        = %[2]q
+}
+`, name, value)
+}
+
+func testAccWorkspaceSecretWriteOnlyResourceConfig(name string, value string, version int) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_terradesk_workspace" "test" {
+  name         = %[1]q
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform"
+	docker_image = "hashicorp/terraform:1.10"
+
+	authoritative_secrets = false
+
+	secrets = {
+		my_secret = {
+			value = "secret-value"
+		}
+	}
+}
+
+resource "devhub_terradesk_workspace_secret" "test" {
+	workspace_id     = devhub_terradesk_workspace.test.id
+	name             = "app_secret"
+	value_wo         = %[2]q
+	value_wo_version = %[3]d
+}
+`, name, value, version)
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	devhub "terraform-provider-devhub/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &workspacesDataSource{}
+	_ datasource.DataSourceWithConfigure = &workspacesDataSource{}
+)
+
+func NewWorkspacesDataSource() datasource.DataSource {
+	return &workspacesDataSource{}
+}
+
+type workspacesDataSource struct {
+	client *devhub.Client
+}
+
+type workspacesDataSourceModel struct {
+	Repository types.String               `tfsdk:"repository"`
+	PathPrefix types.String               `tfsdk:"path_prefix"`
+	AgentId    types.String               `tfsdk:"agent_id"`
+	Workspaces []workspaceDataSourceModel `tfsdk:"workspaces"`
+}
+
+func (d *workspacesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_terradesk_workspaces"
+}
+
+func (d *workspacesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists TerraDesk workspaces, optionally filtered by repository, path or agent.",
+
+		Attributes: map[string]schema.Attribute{
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Only return workspaces of this repository in the format `owner/name`.",
+				Optional:            true,
+			},
+			"path_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return workspaces whose path starts with this prefix, for example `terraform/apps/`.",
+				Optional:            true,
+			},
+			"agent_id": schema.StringAttribute{
+				MarkdownDescription: "Only return workspaces running on this agent.",
+				Optional:            true,
+			},
+			"workspaces": schema.ListNestedAttribute{
+				MarkdownDescription: "The workspaces matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: workspaceDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workspacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state workspacesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaces, err := d.client.ListWorkspaces(devhub.WorkspaceFilter{
+		Repository: state.Repository.ValueString(),
+		PathPrefix: state.PathPrefix.ValueString(),
+		AgentId:    state.AgentId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Workspaces",
+			fmt.Sprintf("Could not list workspaces: %s", err.Error()),
+		)
+		return
+	}
+
+	state.Workspaces = make([]workspaceDataSourceModel, 0, len(workspaces))
+
+	for _, workspace := range workspaces {
+		state.Workspaces = append(state.Workspaces, newWorkspaceDataSourceModel(&workspace))
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workspacesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*devhub.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *devhub.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWorkspacesDataSource(t *testing.T) {
+	name := fmt.Sprintf("workspace_%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by path prefix
+			{
+				Config: testAccWorkspacesDataSourceConfig(name, 2, fmt.Sprintf(`
+	repository  = "devhub-tools/devhub"
+	path_prefix = "terraform/%s/"
+`, name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devhub_terradesk_workspaces.test", "workspaces.#", "2"),
+					resource.TestCheckResourceAttr("data.devhub_terradesk_workspaces.test", "workspaces.0.repository", "devhub-tools/devhub"),
+					resource.TestCheckResourceAttrSet("data.devhub_terradesk_workspaces.test", "workspaces.0.id"),
+				),
+			},
+			// Filters without matches return an empty list
+			{
+				Config: testAccWorkspacesDataSourceConfig(name, 2, fmt.Sprintf(`
+	repository  = "devhub-tools/missing"
+	path_prefix = "terraform/%s/"
+`, name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devhub_terradesk_workspaces.test", "workspaces.#", "0"),
+				),
+			},
+			// More workspaces than fit on one page are all returned
+			{
+				Config: testAccWorkspacesDataSourceConfig(name, 101, fmt.Sprintf(`
+	path_prefix = "terraform/%s/"
+`, name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.devhub_terradesk_workspaces.test", "workspaces.#", "101"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkspacesDataSourceConfig(name string, count int, filters string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "devhub_terradesk_workspace" "test" {
+	count = %[2]d
+
+  name     		 = "%[1]s_${count.index}"
+  repository   = "devhub-tools/devhub"
+	path 				 = "terraform/%[1]s/${count.index}"
+	docker_image = "hashicorp/terraform:1.10"
+}
+
+data "devhub_terradesk_workspaces" "test" {%[3]s
+	depends_on = [devhub_terradesk_workspace.test]
+}
+`, name, count, filters)
+}
@@ -134,8 +134,11 @@ func (r *workflowResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Required:            true,
 			},
 			"cron_schedule": schema.StringAttribute{
-				MarkdownDescription: "A cron expression evaluated using UTC time to trigger the workflow (e.g. 0 0 * * *).",
+				MarkdownDescription: "A cron expression evaluated using UTC time to trigger the workflow (e.g. 0 0 * * *), or a nickname such as `@daily`.",
 				Optional:            true,
+				Validators: []validator.String{
+					cronSchedule(),
+				},
 			},
 			"group": schema.StringAttribute{
 				MarkdownDescription: "Used to organize workflows into folders in the workflow list.",
@@ -315,6 +318,9 @@ func (r *workflowResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 								"slack_channel": schema.StringAttribute{
 									MarkdownDescription: "The Slack channel to post to.",
 									Required:            true,
+									Validators: []validator.String{
+										slackChannel(),
+									},
 								},
 								"message": schema.StringAttribute{
 									MarkdownDescription: "The message to post.",
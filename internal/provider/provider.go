@@ -118,16 +118,30 @@ func (p *devhubProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 func (p *devhubProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewDatabaseDataSource,
+		NewDatabasesDataSource,
 		NewRoleDataSource,
 		NewUserDataSource,
+		NewWorkspaceDataSource,
+		NewWorkspaceOutputsDataSource,
+		NewWorkspacesDataSource,
 	}
 }
 
 func (p *devhubProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		CredentialRotationResource,
 		DashboardResource,
 		DatabaseResource,
+		DatabasePermissionResource,
+		SavedQueryResource,
+		TerradeskPolicySetResource,
+		TerradeskRunTriggerResource,
+		TerradeskVariableSetResource,
 		TerradeskWorkspaceResource,
+		TerradeskWorkspaceEnvVarResource,
+		TerradeskWorkspaceLockResource,
+		TerradeskWorkspaceSecretResource,
 		WorkflowResource,
 	}
 }
@@ -0,0 +1,601 @@
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	frameworkpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/ssh"
+)
+
+var _ validator.String = sshPrivateKeyValidator{}
+
+// sshPrivateKeyValidator checks that a string is an unencrypted private key
+// that can be used to open an ssh connection.
+type sshPrivateKeyValidator struct{}
+
+func sshPrivateKey() validator.String {
+	return sshPrivateKeyValidator{}
+}
+
+func (v sshPrivateKeyValidator) Description(_ context.Context) string {
+	return "value must be an unencrypted ssh private key"
+}
+
+func (v sshPrivateKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v sshPrivateKeyValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := ssh.ParsePrivateKey([]byte(req.ConfigValue.ValueString())); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid SSH Private Key",
+			"The private key could not be parsed, keys must be PEM or OpenSSH encoded and not protected by a passphrase: "+err.Error(),
+		)
+	}
+}
+
+var _ validator.String = durationValidator{}
+
+// durationValidator checks that a string is a positive duration such as `720h`.
+type durationValidator struct{}
+
+func positiveDuration() validator.String {
+	return durationValidator{}
+}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration using the units s, m and h, for example 720h"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || value <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			"Expected "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
+
+var _ validator.String = wholeSecondsDurationValidator{}
+
+// wholeSecondsDurationValidator checks that a duration has no fractional seconds, for durations
+// which DevHub stores in seconds.
+type wholeSecondsDurationValidator struct{}
+
+func wholeSecondsDuration() validator.String {
+	return wholeSecondsDurationValidator{}
+}
+
+func (v wholeSecondsDurationValidator) Description(_ context.Context) string {
+	return "value must be a duration in whole seconds, for example 90s"
+}
+
+func (v wholeSecondsDurationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v wholeSecondsDurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// invalid durations are reported by positiveDuration
+	value, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err == nil && value%time.Second != 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			"Expected "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
+
+var _ validator.String = durationBetweenValidator{}
+
+// durationBetweenValidator checks that a duration is within an inclusive range.
+type durationBetweenValidator struct {
+	min time.Duration
+	max time.Duration
+}
+
+func durationBetween(min time.Duration, max time.Duration) validator.String {
+	return durationBetweenValidator{min: min, max: max}
+}
+
+func (v durationBetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a duration between %s and %s", formatDuration(v.min), formatDuration(v.max))
+}
+
+func (v durationBetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationBetweenValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// invalid durations are reported by positiveDuration
+	value, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err == nil && (value < v.min || value > v.max) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			"Expected "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
+
+var _ validator.String = pemCertificateValidator{}
+
+// pemCertificateValidator checks that a string is a pem encoded x509 certificate.
+type pemCertificateValidator struct{}
+
+func pemCertificate() validator.String {
+	return pemCertificateValidator{}
+}
+
+func (v pemCertificateValidator) Description(_ context.Context) string {
+	return "value must be a pem encoded certificate"
+}
+
+func (v pemCertificateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v pemCertificateValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parsePemCertificate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Certificate",
+			"The certificate could not be parsed, it must be a PEM encoded x509 certificate: "+err.Error(),
+		)
+	}
+}
+
+// parsePemCertificate parses the first certificate of a pem file.
+func parsePemCertificate(data string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("no CERTIFICATE pem block found")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+var _ validator.String = globPatternValidator{}
+
+// globPatternValidator checks that a string is a valid glob pattern.
+type globPatternValidator struct{}
+
+func globPattern() validator.String {
+	return globPatternValidator{}
+}
+
+func (v globPatternValidator) Description(_ context.Context) string {
+	return "value must be a valid glob pattern"
+}
+
+func (v globPatternValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v globPatternValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// Match only reports a bad pattern, the name matched against doesn't matter
+	if _, err := path.Match(req.ConfigValue.ValueString(), ""); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Glob Pattern",
+			"Expected a glob pattern such as `modules/**`, got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
+
+var _ validator.String = cronScheduleValidator{}
+
+// cronScheduleValidator checks that a string is a cron expression with the five
+// standard fields: minute, hour, day of month, month and day of week, or a nickname
+// such as `@daily`.
+type cronScheduleValidator struct{}
+
+func cronSchedule() validator.String {
+	return cronScheduleValidator{}
+}
+
+func (v cronScheduleValidator) Description(_ context.Context) string {
+	return "value must be a cron expression with five fields or a nickname, for example 0 0 * * * or @daily"
+}
+
+func (v cronScheduleValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronScheduleValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := parseCronSchedule(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Cron Schedule",
+			fmt.Sprintf("Expected a cron expression such as `0 0 * * *` or `@daily`, got %q: %s", req.ConfigValue.ValueString(), err.Error()),
+		)
+	}
+}
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	// 7 is accepted as Sunday like most cron implementations do
+	{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+// cronNicknames are the nicknames accepted instead of the five fields.
+var cronNicknames = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}
+
+// parseCronSchedule checks each field of a cron expression, every item of a field
+// can be `*`, a value or a range optionally followed by a step like `*/15` or `1-5/2`.
+func parseCronSchedule(schedule string) error {
+	if strings.HasPrefix(schedule, "@") {
+		if !slices.Contains(cronNicknames, schedule) {
+			return fmt.Errorf("nickname must be one of %s", strings.Join(cronNicknames, ", "))
+		}
+		return nil
+	}
+
+	fields := strings.Fields(schedule)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("expected %d fields, got %d", len(cronFields), len(fields))
+	}
+
+	for i, field := range cronFields {
+		for _, item := range strings.Split(fields[i], ",") {
+			if err := field.parseItem(item); err != nil {
+				return fmt.Errorf("invalid %s %q: %w", field.name, item, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (f cronField) parseItem(item string) error {
+	values, step, hasStep := strings.Cut(item, "/")
+
+	if hasStep {
+		n, err := strconv.Atoi(step)
+		if err != nil || n <= 0 {
+			return errors.New("step must be a positive number")
+		}
+	}
+
+	if values == "*" {
+		return nil
+	}
+
+	start, end, isRange := strings.Cut(values, "-")
+
+	first, err := f.parseValue(start)
+	if err != nil {
+		return err
+	}
+
+	if !isRange {
+		return nil
+	}
+
+	last, err := f.parseValue(end)
+	if err != nil {
+		return err
+	}
+
+	if first > last {
+		return errors.New("range start is after its end")
+	}
+
+	return nil
+}
+
+func (f cronField) parseValue(value string) (int, error) {
+	if i := slices.Index(f.names, strings.ToUpper(value)); i >= 0 {
+		return i + f.min, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("value must be between %d and %d", f.min, f.max)
+	}
+
+	return n, nil
+}
+
+var _ validator.String = quantityValidator{}
+
+// quantityRegexp matches non negative kubernetes resource quantities like `500m`, `2Gi` or `1e3`.
+var quantityRegexp = regexp.MustCompile(`^([0-9]+(\.[0-9]*)?|\.[0-9]+)([KMGTPE]i|[numkMGTPE]|[eE][+-]?[0-9]+)?$`)
+
+// quantityValidator checks that a string is a kubernetes resource quantity.
+type quantityValidator struct{}
+
+func kubernetesQuantity() validator.String {
+	return quantityValidator{}
+}
+
+func (v quantityValidator) Description(_ context.Context) string {
+	return "value must be a kubernetes quantity, for example 500m or 2Gi"
+}
+
+func (v quantityValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v quantityValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !quantityRegexp.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Quantity",
+			"Expected "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
+
+var _ validator.String = versionConstraintValidator{}
+
+// versionConstraintValidator checks that a string is a version constraint like `~> 1.10.0`.
+type versionConstraintValidator struct{}
+
+func versionConstraint() validator.String {
+	return versionConstraintValidator{}
+}
+
+func (v versionConstraintValidator) Description(_ context.Context) string {
+	return "value must be a version constraint, for example ~> 1.10.0 or >= 1.9, < 2.0"
+}
+
+func (v versionConstraintValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v versionConstraintValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := version.NewConstraint(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Version Constraint",
+			"Expected a version constraint such as `~> 1.10.0`: "+err.Error(),
+		)
+	}
+}
+
+var _ validator.String = regoPolicyValidator{}
+
+// regoPolicyValidator does basic syntax checks of a Rego policy. It doesn't parse
+// the rules, those are checked by DevHub when the policy is evaluated.
+type regoPolicyValidator struct{}
+
+func regoPolicy() validator.String {
+	return regoPolicyValidator{}
+}
+
+func (v regoPolicyValidator) Description(_ context.Context) string {
+	return "value must be a Rego policy starting with a package declaration"
+}
+
+func (v regoPolicyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regoPolicyValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkRegoSyntax(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Rego Policy",
+			"The policy has a syntax error: "+err.Error(),
+		)
+	}
+}
+
+var regoPackageRegexp = regexp.MustCompile(`^package\s+[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*\s*$`)
+
+// checkRegoSyntax checks that the policy starts with a package declaration and that
+// strings are terminated and brackets are balanced, skipping comments.
+func checkRegoSyntax(policy string) error {
+	declaration := ""
+	for _, line := range strings.Split(policy, "\n") {
+		line, _, _ = strings.Cut(line, "#")
+		if line = strings.TrimSpace(line); line != "" {
+			declaration = line
+			break
+		}
+	}
+
+	if !regoPackageRegexp.MatchString(declaration) {
+		return errors.New("the policy must start with a package declaration like `package terraform.policies`")
+	}
+
+	closing := map[rune]rune{')': '(', ']': '[', '}': '{'}
+	var open []rune
+	var openLines []int
+
+	line := 1
+	runes := []rune(policy)
+
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; r {
+		case '\n':
+			line++
+		case '#':
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+		case '"':
+			start := line
+			for i++; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' {
+					i++
+				} else if runes[i] == '\n' {
+					return fmt.Errorf("line %d: unterminated string", start)
+				}
+			}
+			if i >= len(runes) {
+				return fmt.Errorf("line %d: unterminated string", start)
+			}
+		case '`':
+			start := line
+			for i++; i < len(runes) && runes[i] != '`'; i++ {
+				if runes[i] == '\n' {
+					line++
+				}
+			}
+			if i >= len(runes) {
+				return fmt.Errorf("line %d: unterminated raw string", start)
+			}
+		case '(', '[', '{':
+			open = append(open, r)
+			openLines = append(openLines, line)
+		case ')', ']', '}':
+			if len(open) == 0 || open[len(open)-1] != closing[r] {
+				return fmt.Errorf("line %d: unexpected %q", line, r)
+			}
+			open = open[:len(open)-1]
+			openLines = openLines[:len(openLines)-1]
+		}
+	}
+
+	if len(open) > 0 {
+		return fmt.Errorf("line %d: %q is never closed", openLines[len(openLines)-1], open[len(open)-1])
+	}
+
+	return nil
+}
+
+var _ validator.String = slackChannelValidator{}
+
+// slackChannelRegexp matches Slack channel names with an optional leading `#` and channel ids.
+var slackChannelRegexp = regexp.MustCompile(`^(#?[a-z0-9][a-z0-9_-]{0,79}|[CG][A-Z0-9]{8,})$`)
+
+// slackChannelValidator checks that a string is a Slack channel name like `#infra` or a channel id.
+type slackChannelValidator struct{}
+
+func slackChannel() validator.String {
+	return slackChannelValidator{}
+}
+
+func (v slackChannelValidator) Description(_ context.Context) string {
+	return "value must be a Slack channel name using lowercase letters, numbers, hyphens and underscores, for example #infra, or a channel id"
+}
+
+func (v slackChannelValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v slackChannelValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !slackChannelRegexp.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Slack Channel",
+			"Expected "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
+
+var _ validator.Set = conflictsWithEnabledValidator{}
+
+// conflictsWithEnabledValidator checks that a set isn't configured together with a bool
+// attribute set to true, unlike ConflictsWith an explicit false is allowed.
+type conflictsWithEnabledValidator struct {
+	attribute frameworkpath.Path
+}
+
+func conflictsWithEnabled(attribute frameworkpath.Path) validator.Set {
+	return conflictsWithEnabledValidator{attribute: attribute}
+}
+
+func (v conflictsWithEnabledValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value can't be set when %s is true", v.attribute)
+}
+
+func (v conflictsWithEnabledValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v conflictsWithEnabledValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+
+	var enabled types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, v.attribute, &enabled)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if enabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Attribute %q cannot be specified when %q is true", req.Path, v.attribute),
+		)
+	}
+}